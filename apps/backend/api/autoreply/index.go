@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/application/autoreply"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// Handler Vercel Functions のハンドラ
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// CORS対応
+	httphelper.SetCORS(w)
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	container := di.GetContainer()
+	ctx := context.Background()
+
+	// /api/autoreply/rules、/api/autoreply/rules/{id}、/api/autoreply/test
+	segments := httphelper.PathSegments(r, "/api/autoreply")
+	switch {
+	case len(segments) == 1 && segments[0] == "rules":
+		handleRules(w, r, ctx, container)
+	case len(segments) == 2 && segments[0] == "rules":
+		handleRuleByID(w, r, ctx, container, segments[1])
+	case len(segments) == 1 && segments[0] == "test":
+		if r.Method != "POST" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleTestRule(w, r, ctx, container)
+	default:
+		httphelper.WriteError(w, errx.ErrNotFound)
+	}
+}
+
+func handleRules(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	switch r.Method {
+	case "GET":
+		handleGetRules(w, r, ctx, container)
+	case "POST":
+		handleCreateRule(w, r, ctx, container)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleRuleByID(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, segment string) {
+	id, err := uuid.Parse(segment)
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetRule(w, ctx, container, id)
+	case "PUT", "PATCH":
+		handleUpdateRule(w, r, ctx, container, id)
+	case "DELETE":
+		handleDeleteRule(w, ctx, container, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleGetRules(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	// クエリパラメータを取得
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 100 // デフォルト（ルールは照合順に全件表示する想定）
+	if limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0 // デフォルト
+	if offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	input := &autoreply.ListRulesInput{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	rules, err := container.AutoReplyUsecase.ListRules(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, rules)
+}
+
+func handleCreateRule(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input autoreply.CreateRuleInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	created, err := container.AutoReplyUsecase.CreateRule(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusCreated, created)
+}
+
+func handleGetRule(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	found, err := container.AutoReplyUsecase.GetRule(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, found)
+}
+
+func handleUpdateRule(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	var input autoreply.UpdateRuleInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	input.ID = id
+
+	updated, err := container.AutoReplyUsecase.UpdateRule(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, updated)
+}
+
+func handleDeleteRule(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	if err := container.AutoReplyUsecase.DeleteRule(ctx, id); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleTestRule {"text": "...", "at": "RFC 3339"} を受信した場合に応答するルールを返す（返信はしない）
+func handleTestRule(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input autoreply.TestRuleInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	result, err := container.AutoReplyUsecase.TestRule(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/application/follower"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// Handler Vercel Functions のハンドラ
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// CORS対応
+	httphelper.SetCORS(w)
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	container := di.GetContainer()
+	ctx := context.Background()
+
+	// /api/followers/{id} と /api/followers/{id}/refresh はID指定のハンドラへ
+	if segments := httphelper.PathSegments(r, "/api/followers"); len(segments) > 0 {
+		handleFollowerByID(w, r, ctx, container, segments)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetFollowers(w, r, ctx, container)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleFollowerByID(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, segments []string) {
+	id, err := uuid.Parse(segments[0])
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && r.Method == "GET":
+		handleGetFollower(w, ctx, container, id)
+	case len(segments) == 2 && segments[1] == "refresh" && r.Method == "POST":
+		handleRefreshProfile(w, ctx, container, id)
+	case len(segments) == 1, len(segments) == 2 && segments[1] == "refresh":
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	default:
+		httphelper.WriteError(w, errx.ErrNotFound)
+	}
+}
+
+// handleGetFollowers q（表示名・LINEユーザーID）、status、language で絞り込んだ友だち一覧
+func handleGetFollowers(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	// クエリパラメータを取得
+	query := r.URL.Query()
+	limitStr := query.Get("limit")
+	offsetStr := query.Get("offset")
+
+	limit := 20 // デフォルト
+	if limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0 // デフォルト
+	if offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	input := &follower.ListFollowersInput{
+		Status:   model.FollowerStatus(query.Get("status")),
+		Query:    query.Get("q"),
+		Language: query.Get("language"),
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	page, err := container.FollowerUsecase.ListFollowers(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSONWithMeta(w, http.StatusOK, page.Followers, &httphelper.Meta{
+		Total: page.Total,
+	})
+}
+
+func handleGetFollower(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	found, err := container.FollowerUsecase.GetFollower(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, found)
+}
+
+func handleRefreshProfile(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	refreshed, err := container.FollowerUsecase.RefreshProfile(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, refreshed)
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"vt-link/backend/internal/application/webhook"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// maxWebhookBodySize 受け付けるリクエストボディの上限
+const maxWebhookBodySize = 1 << 20
+
+// Handler Vercel Functions のハンドラ（LINE Messaging API の Webhook）
+func Handler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// 署名はリクエストボディそのものに対して計算されるため、パースせずに読み込む
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	container := di.GetContainer()
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Second) // Vercel Functions タイムアウト対策
+	defer cancel()
+
+	input := &webhook.HandleWebhookInput{
+		Body:      body,
+		Signature: r.Header.Get("X-Line-Signature"),
+	}
+	if err := container.WebhookUsecase.HandleWebhook(ctx, input); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, nil)
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"vt-link/backend/internal/application/message"
+	"vt-link/backend/internal/domain/model"
 	"vt-link/backend/internal/infrastructure/di"
 	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
 )
 
 // Handler Vercel Functions のハンドラ
@@ -23,9 +28,41 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 	container := di.GetContainer()
 	ctx := context.Background()
 
+	segments := httphelper.PathSegments(r, "/api/messages")
+	if len(segments) == 1 && segments[0] == "trash" {
+		if r.Method != "GET" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleGetMessages(w, r, ctx, container, true)
+		return
+	}
+	if len(segments) == 1 && segments[0] == "quota" {
+		if r.Method != "GET" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleGetDeliveryUsage(w, ctx, container)
+		return
+	}
+	if len(segments) == 1 && segments[0] == "search" {
+		if r.Method != "GET" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleSearchMessages(w, r, ctx, container)
+		return
+	}
+
+	// /api/messages/{id} 配下はID指定のハンドラへ
+	if len(segments) > 0 {
+		handleMessageByID(w, r, ctx, container, segments)
+		return
+	}
+
 	switch r.Method {
 	case "GET":
-		handleGetMessages(w, r, ctx, container)
+		handleGetMessages(w, r, ctx, container, false)
 	case "POST":
 		handleCreateMessage(w, r, ctx, container)
 	default:
@@ -33,10 +70,88 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func handleGetMessages(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+func handleMessageByID(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, segments []string) {
+	id, err := uuid.Parse(segments[0])
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	if len(segments) > 1 {
+		handleMessageAction(w, r, ctx, container, id, segments[1:])
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetMessage(w, ctx, container, id)
+	case "PUT", "PATCH":
+		handleUpdateMessage(w, r, ctx, container, id)
+	case "DELETE":
+		handleDeleteMessage(w, ctx, container, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleMessageAction /api/messages/{id}/{action} のディスパッチ
+func handleMessageAction(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID, action []string) {
+	if action[0] == "revisions" {
+		handleRevisionAction(w, r, ctx, container, id, action[1:])
+		return
+	}
+	if len(action) != 1 {
+		httphelper.WriteError(w, errx.ErrNotFound)
+		return
+	}
+
+	switch {
+	case action[0] == "schedule" && r.Method == "POST":
+		handleScheduleMessage(w, r, ctx, container, id)
+	case action[0] == "schedule" && r.Method == "DELETE":
+		handleUnscheduleMessage(w, ctx, container, id)
+	case action[0] == "cancel" && r.Method == "POST":
+		handleCancelMessage(w, ctx, container, id)
+	case action[0] == "duplicate" && r.Method == "POST":
+		handleDuplicateMessage(w, ctx, container, id)
+	case action[0] == "deliveries" && r.Method == "GET":
+		handleListDeliveries(w, ctx, container, id)
+	case action[0] == "restore" && r.Method == "POST":
+		handleRestoreMessage(w, ctx, container, id)
+	case action[0] == "archive" && r.Method == "POST":
+		handleArchiveMessage(w, ctx, container, id)
+	case action[0] == "schedule", action[0] == "cancel", action[0] == "duplicate", action[0] == "restore", action[0] == "archive", action[0] == "deliveries":
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	default:
+		httphelper.WriteError(w, errx.ErrNotFound)
+	}
+}
+
+// handleRevisionAction /api/messages/{id}/revisions[/{revision}/restore] のディスパッチ
+func handleRevisionAction(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID, rest []string) {
+	switch {
+	case len(rest) == 0 && r.Method == "GET":
+		handleListRevisions(w, ctx, container, id)
+	case len(rest) == 2 && rest[1] == "restore" && r.Method == "POST":
+		revision, err := strconv.Atoi(rest[0])
+		if err != nil || revision <= 0 {
+			httphelper.WriteError(w, errx.ErrInvalidInput)
+			return
+		}
+		handleRestoreRevision(w, r, ctx, container, id, revision)
+	case len(rest) == 0, len(rest) == 2 && rest[1] == "restore":
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	default:
+		httphelper.WriteError(w, errx.ErrNotFound)
+	}
+}
+
+// handleGetMessages trash が true の場合はゴミ箱の一覧を返す
+func handleGetMessages(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, trash bool) {
 	// クエリパラメータを取得
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
+	query := r.URL.Query()
+	limitStr := query.Get("limit")
+	offsetStr := query.Get("offset")
 
 	limit := 20 // デフォルト
 	if limitStr != "" {
@@ -53,17 +168,102 @@ func handleGetMessages(w http.ResponseWriter, r *http.Request, ctx context.Conte
 	}
 
 	input := &message.ListMessagesInput{
+		Limit:   limit,
+		Offset:  offset,
+		Cursor:  query.Get("cursor"),
+		Sort:    query.Get("sort"),
+		Deleted: trash,
+	}
+
+	// source_id={id} で指定したメッセージから複製されたものに絞り込む
+	if value := query.Get("source_id"); value != "" {
+		sourceID, err := uuid.Parse(value)
+		if err != nil {
+			httphelper.WriteError(w, errx.NewAppError("INVALID_FILTER", "source_id must be a message ID", 400))
+			return
+		}
+		input.SourceID = &sourceID
+	}
+
+	// status=draft,scheduled または status=draft&status=scheduled
+	for _, value := range query["status"] {
+		for _, status := range strings.Split(value, ",") {
+			if status != "" {
+				input.Statuses = append(input.Statuses, model.MessageStatus(status))
+			}
+		}
+	}
+
+	// tag={id} を複数指定した場合はいずれかのタグが付いたメッセージ
+	for _, value := range query["tag"] {
+		for _, raw := range strings.Split(value, ",") {
+			tagID, err := uuid.Parse(raw)
+			if err != nil {
+				httphelper.WriteError(w, errx.NewAppError("INVALID_FILTER", "tag must be a tag ID", 400))
+				return
+			}
+			input.TagIDs = append(input.TagIDs, tagID)
+		}
+	}
+
+	ranges := []struct {
+		param string
+		dest  **time.Time
+	}{
+		{"scheduled_from", &input.ScheduledFrom},
+		{"scheduled_to", &input.ScheduledTo},
+		{"sent_from", &input.SentFrom},
+		{"sent_to", &input.SentTo},
+	}
+	for _, rng := range ranges {
+		value := query.Get(rng.param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			httphelper.WriteError(w, errx.NewAppError("INVALID_FILTER", rng.param+" must be an RFC 3339 date-time", 400))
+			return
+		}
+		*rng.dest = &parsed
+	}
+
+	page, err := container.MessageUsecase.ListMessages(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSONWithMeta(w, http.StatusOK, page.Messages, &httphelper.Meta{
+		Total:      page.Total,
+		NextCursor: page.NextCursor,
+	})
+}
+
+func handleSearchMessages(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	limit := 20 // デフォルト
+	if parsed, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && parsed > 0 {
+		limit = parsed
+	}
+
+	offset := 0 // デフォルト
+	if parsed, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && parsed >= 0 {
+		offset = parsed
+	}
+
+	input := &message.SearchMessagesInput{
+		Query:  r.URL.Query().Get("q"),
 		Limit:  limit,
 		Offset: offset,
 	}
 
-	messages, err := container.MessageUsecase.ListMessages(ctx, input)
+	results, err := container.MessageUsecase.SearchMessages(ctx, input)
 	if err != nil {
 		httphelper.WriteError(w, err)
 		return
 	}
 
-	httphelper.WriteJSON(w, http.StatusOK, messages)
+	httphelper.WriteJSON(w, http.StatusOK, results)
 }
 
 func handleCreateMessage(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
@@ -72,6 +272,7 @@ func handleCreateMessage(w http.ResponseWriter, r *http.Request, ctx context.Con
 		httphelper.WriteError(w, err)
 		return
 	}
+	input.Actor = httphelper.Actor(r)
 
 	newMessage, err := container.MessageUsecase.CreateMessage(ctx, &input)
 	if err != nil {
@@ -81,3 +282,154 @@ func handleCreateMessage(w http.ResponseWriter, r *http.Request, ctx context.Con
 
 	httphelper.WriteJSON(w, http.StatusCreated, newMessage)
 }
+
+func handleGetMessage(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	found, err := container.MessageUsecase.GetMessage(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, found)
+}
+
+func handleUpdateMessage(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	var input message.UpdateMessageInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	input.ID = id
+	input.Actor = httphelper.Actor(r)
+
+	updated, err := container.MessageUsecase.UpdateMessage(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, updated)
+}
+
+func handleScheduleMessage(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	var input message.ScheduleMessageInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	input.ID = id
+	input.Actor = httphelper.Actor(r)
+
+	scheduled, err := container.MessageUsecase.ScheduleMessage(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, scheduled)
+}
+
+func handleUnscheduleMessage(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	unscheduled, err := container.MessageUsecase.UnscheduleMessage(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, unscheduled)
+}
+
+func handleCancelMessage(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	canceled, err := container.MessageUsecase.CancelMessage(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, canceled)
+}
+
+func handleDuplicateMessage(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	duplicated, err := container.MessageUsecase.DuplicateMessage(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusCreated, duplicated)
+}
+
+func handleDeleteMessage(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	if err := container.MessageUsecase.DeleteMessage(ctx, id); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleRestoreMessage(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	restored, err := container.MessageUsecase.RestoreMessage(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, restored)
+}
+
+func handleArchiveMessage(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	archived, err := container.MessageUsecase.ArchiveMessage(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, archived)
+}
+
+func handleGetDeliveryUsage(w http.ResponseWriter, ctx context.Context, container *di.Container) {
+	usage, err := container.MessageUsecase.GetDeliveryUsage(ctx)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, usage)
+}
+
+func handleListDeliveries(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	batches, err := container.MessageUsecase.ListDeliveries(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, batches)
+}
+
+func handleListRevisions(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	revisions, err := container.MessageUsecase.ListRevisions(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, revisions)
+}
+
+func handleRestoreRevision(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID, revision int) {
+	input := &message.RestoreRevisionInput{
+		ID:       id,
+		Revision: revision,
+		Actor:    httphelper.Actor(r),
+	}
+
+	restored, err := container.MessageUsecase.RestoreRevision(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, restored)
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/application/richmenu"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// Handler Vercel Functions のハンドラ
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// CORS対応
+	httphelper.SetCORS(w)
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	container := di.GetContainer()
+	// LINEへの作り直し（画像のアップロード・ユーザーの再リンク）を含むため、Vercel Functions のタイムアウトより前に打ち切る
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Second)
+	defer cancel()
+
+	segments := httphelper.PathSegments(r, "/api/richmenus")
+	switch {
+	case len(segments) == 1 && segments[0] == "unlink":
+		// /api/richmenus/unlink はリッチメニューを問わずユーザーのリンクを解除する
+		if r.Method != "POST" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleUnlinkUsers(w, r, ctx, container)
+		return
+	case len(segments) > 0:
+		handleRichMenuByID(w, r, ctx, container, segments)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetRichMenus(w, r, ctx, container)
+	case "POST":
+		handleCreateRichMenu(w, r, ctx, container)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleRichMenuByID(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, segments []string) {
+	id, err := uuid.Parse(segments[0])
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case "GET":
+			handleGetRichMenu(w, ctx, container, id)
+		case "PUT":
+			handleUpdateRichMenu(w, r, ctx, container, id)
+		case "DELETE":
+			handleDeleteRichMenu(w, ctx, container, id)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	if len(segments) != 2 {
+		httphelper.WriteError(w, errx.ErrNotFound)
+		return
+	}
+	switch {
+	case segments[1] == "image" && r.Method == "PUT":
+		handleUploadImage(w, r, ctx, container, id)
+	case segments[1] == "apply" && r.Method == "POST":
+		handleApplyRichMenu(w, ctx, container, id)
+	case segments[1] == "default" && r.Method == "POST":
+		handleSetDefault(w, ctx, container, id)
+	case segments[1] == "link" && r.Method == "POST":
+		handleLinkUsers(w, r, ctx, container, id)
+	case segments[1] == "revisions" && r.Method == "GET":
+		handleListRevisions(w, ctx, container, id)
+	case segments[1] == "image", segments[1] == "apply", segments[1] == "default", segments[1] == "link", segments[1] == "revisions":
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	default:
+		httphelper.WriteError(w, errx.ErrNotFound)
+	}
+}
+
+func handleGetRichMenus(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	// クエリパラメータを取得
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 20 // デフォルト
+	if limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0 // デフォルト
+	if offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	input := &richmenu.ListRichMenusInput{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	menus, err := container.RichMenuUsecase.ListRichMenus(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, menus)
+}
+
+func handleCreateRichMenu(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input richmenu.CreateRichMenuInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	created, err := container.RichMenuUsecase.CreateRichMenu(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusCreated, created)
+}
+
+func handleGetRichMenu(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	menu, err := container.RichMenuUsecase.GetRichMenu(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, menu)
+}
+
+func handleUpdateRichMenu(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	var input richmenu.UpdateRichMenuInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	input.ID = id
+
+	updated, err := container.RichMenuUsecase.UpdateRichMenu(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, updated)
+}
+
+func handleDeleteRichMenu(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	if err := container.RichMenuUsecase.DeleteRichMenu(ctx, id); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleUploadImage リクエストボディの画像（Content-Type: image/jpeg または image/png）を登録
+func handleUploadImage(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	defer r.Body.Close()
+	// 上限を1バイト超えて読み、サイズ超過は画像の検証エラーとして返す
+	image, err := io.ReadAll(io.LimitReader(r.Body, model.RichMenuImageMaxBytes+1))
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		contentType = r.Header.Get("Content-Type")
+	}
+
+	input := &richmenu.UploadImageInput{
+		ID:          id,
+		ContentType: contentType,
+		Image:       image,
+	}
+
+	updated, err := container.RichMenuUsecase.UploadImage(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, updated)
+}
+
+func handleApplyRichMenu(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	applied, err := container.RichMenuUsecase.ApplyRichMenu(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, applied)
+}
+
+func handleSetDefault(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	menu, err := container.RichMenuUsecase.SetDefault(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, menu)
+}
+
+// handleLinkUsers {"user_ids": [...]} のユーザーにリッチメニューをリンク
+func handleLinkUsers(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	var input richmenu.LinkUsersInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	input.ID = id
+
+	if err := container.RichMenuUsecase.LinkUsers(ctx, &input); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleUnlinkUsers {"user_ids": [...]} のユーザーのリンクを解除（既定のリッチメニューが表示される）
+func handleUnlinkUsers(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input richmenu.UnlinkUsersInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	if err := container.RichMenuUsecase.UnlinkUsers(ctx, &input); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleListRevisions(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	revisions, err := container.RichMenuUsecase.ListRevisions(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, revisions)
+}
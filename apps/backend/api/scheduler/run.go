@@ -12,9 +12,12 @@ import (
 )
 
 type SchedulerResult struct {
-	ProcessedCount int    `json:"processed_count"`
-	Message        string `json:"message"`
-	Timestamp      string `json:"timestamp"`
+	// ProcessedCount 送信済みになったメッセージ数
+	ProcessedCount int `json:"processed_count"`
+	// AcceptedCount LINEが受け付け、完了待ちの絞り込み配信数
+	AcceptedCount int    `json:"accepted_count"`
+	Message       string `json:"message"`
+	Timestamp     string `json:"timestamp"`
 }
 
 // Handler Vercel Functions のハンドラ
@@ -55,14 +58,15 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		Limit: 30, // Vercel Functions環境での安全な処理数
 	}
 
-	processedCount, err := container.MessageUsecase.RunScheduler(ctx, input)
+	scheduled, err := container.MessageUsecase.RunScheduler(ctx, input)
 	if err != nil {
 		httphelper.WriteError(w, err)
 		return
 	}
 
 	result := SchedulerResult{
-		ProcessedCount: processedCount,
+		ProcessedCount: scheduled.Sent,
+		AcceptedCount:  scheduled.Accepted,
 		Message:        "Scheduler executed successfully",
 		Timestamp:      now.UTC().Format(time.RFC3339),
 	}
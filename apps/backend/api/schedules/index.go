@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/application/recurrence"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// Handler Vercel Functions のハンドラ
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// CORS対応
+	httphelper.SetCORS(w)
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	container := di.GetContainer()
+	ctx := context.Background()
+
+	// /api/schedules/{id} 配下はID指定のハンドラへ
+	if segments := httphelper.PathSegments(r, "/api/schedules"); len(segments) > 0 {
+		handleScheduleByID(w, r, ctx, container, segments)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetSchedules(w, r, ctx, container)
+	case "POST":
+		handleCreateSchedule(w, r, ctx, container)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleScheduleByID(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, segments []string) {
+	id, err := uuid.Parse(segments[0])
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	if len(segments) > 1 {
+		handleScheduleAction(w, r, ctx, container, id, segments[1:])
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetSchedule(w, ctx, container, id)
+	case "DELETE":
+		handleDeleteSchedule(w, ctx, container, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleScheduleAction /api/schedules/{id}/{action} のディスパッチ
+func handleScheduleAction(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID, action []string) {
+	if len(action) != 1 {
+		httphelper.WriteError(w, errx.ErrNotFound)
+		return
+	}
+
+	switch {
+	case action[0] == "pause" && r.Method == "POST":
+		handlePauseSchedule(w, ctx, container, id)
+	case action[0] == "resume" && r.Method == "POST":
+		handleResumeSchedule(w, ctx, container, id)
+	case action[0] == "preview" && r.Method == "GET":
+		handlePreviewSchedule(w, r, ctx, container, id)
+	case action[0] == "pause", action[0] == "resume", action[0] == "preview":
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	default:
+		httphelper.WriteError(w, errx.ErrNotFound)
+	}
+}
+
+func handleGetSchedules(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	// クエリパラメータを取得
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 20 // デフォルト
+	if limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0 // デフォルト
+	if offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	input := &recurrence.ListSchedulesInput{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	schedules, err := container.RecurrenceUsecase.ListSchedules(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, schedules)
+}
+
+func handleCreateSchedule(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input recurrence.CreateScheduleInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	created, err := container.RecurrenceUsecase.CreateSchedule(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusCreated, created)
+}
+
+func handleGetSchedule(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	found, err := container.RecurrenceUsecase.GetSchedule(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, found)
+}
+
+func handleDeleteSchedule(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	if err := container.RecurrenceUsecase.DeleteSchedule(ctx, id); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handlePauseSchedule(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	paused, err := container.RecurrenceUsecase.PauseSchedule(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, paused)
+}
+
+func handleResumeSchedule(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	resumed, err := container.RecurrenceUsecase.ResumeSchedule(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, resumed)
+}
+
+func handlePreviewSchedule(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	input := &recurrence.PreviewScheduleInput{ID: id}
+	if countStr := r.URL.Query().Get("count"); countStr != "" {
+		if parsed, err := strconv.Atoi(countStr); err == nil && parsed > 0 {
+			input.Count = parsed
+		}
+	}
+
+	occurrences, err := container.RecurrenceUsecase.PreviewSchedule(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, occurrences)
+}
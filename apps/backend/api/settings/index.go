@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"vt-link/backend/internal/application/workspace"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// Handler Vercel Functions のハンドラ
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// CORS対応
+	httphelper.SetCORS(w)
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	container := di.GetContainer()
+	ctx := context.Background()
+
+	switch r.Method {
+	case "GET":
+		handleGetSettings(w, ctx, container)
+	case "PUT", "PATCH":
+		handleUpdateSettings(w, r, ctx, container)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleGetSettings(w http.ResponseWriter, ctx context.Context, container *di.Container) {
+	settings, err := container.WorkspaceUsecase.GetSettings(ctx)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, settings)
+}
+
+func handleUpdateSettings(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input workspace.UpdateSettingsInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	updated, err := container.WorkspaceUsecase.UpdateSettings(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, updated)
+}
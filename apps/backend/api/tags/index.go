@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/application/tag"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// Handler Vercel Functions のハンドラ
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// CORS対応
+	httphelper.SetCORS(w)
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	container := di.GetContainer()
+	ctx := context.Background()
+
+	// /api/tags/{id} はID指定のハンドラへ
+	if segments := httphelper.PathSegments(r, "/api/tags"); len(segments) > 0 {
+		handleTagByID(w, r, ctx, container, segments)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetTags(w, r, ctx, container)
+	case "POST":
+		handleCreateTag(w, r, ctx, container)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleTagByID(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, segments []string) {
+	if len(segments) != 1 {
+		httphelper.WriteError(w, errx.ErrNotFound)
+		return
+	}
+
+	id, err := uuid.Parse(segments[0])
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetTag(w, ctx, container, id)
+	case "PUT", "PATCH":
+		handleUpdateTag(w, r, ctx, container, id)
+	case "DELETE":
+		handleDeleteTag(w, ctx, container, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleGetTags(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	// クエリパラメータを取得
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 20 // デフォルト
+	if limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0 // デフォルト
+	if offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	input := &tag.ListTagsInput{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	tags, err := container.TagUsecase.ListTags(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, tags)
+}
+
+func handleCreateTag(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input tag.CreateTagInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	created, err := container.TagUsecase.CreateTag(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusCreated, created)
+}
+
+func handleGetTag(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	found, err := container.TagUsecase.GetTag(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, found)
+}
+
+func handleUpdateTag(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	var input tag.UpdateTagInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	input.ID = id
+
+	updated, err := container.TagUsecase.UpdateTag(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, updated)
+}
+
+func handleDeleteTag(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	if err := container.TagUsecase.DeleteTag(ctx, id); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
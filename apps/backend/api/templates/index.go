@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/application/messagetemplate"
+	"vt-link/backend/internal/infrastructure/di"
+	httphelper "vt-link/backend/internal/infrastructure/http"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// Handler Vercel Functions のハンドラ
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// CORS対応
+	httphelper.SetCORS(w)
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	container := di.GetContainer()
+	ctx := context.Background()
+
+	// /api/templates/{id} はID指定のハンドラへ
+	if segments := httphelper.PathSegments(r, "/api/templates"); len(segments) > 0 {
+		handleTemplateByID(w, r, ctx, container, segments)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetTemplates(w, r, ctx, container)
+	case "POST":
+		handleCreateTemplate(w, r, ctx, container)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleTemplateByID(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, segments []string) {
+	if len(segments) != 1 {
+		httphelper.WriteError(w, errx.ErrNotFound)
+		return
+	}
+
+	id, err := uuid.Parse(segments[0])
+	if err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		handleGetTemplate(w, ctx, container, id)
+	case "PUT", "PATCH":
+		handleUpdateTemplate(w, r, ctx, container, id)
+	case "DELETE":
+		handleDeleteTemplate(w, ctx, container, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleGetTemplates(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	// クエリパラメータを取得
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 20 // デフォルト
+	if limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0 // デフォルト
+	if offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	input := &messagetemplate.ListTemplatesInput{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	templates, err := container.MessageTemplateUsecase.ListTemplates(ctx, input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, templates)
+}
+
+func handleCreateTemplate(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container) {
+	var input messagetemplate.CreateTemplateInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+
+	created, err := container.MessageTemplateUsecase.CreateTemplate(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusCreated, created)
+}
+
+func handleGetTemplate(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	found, err := container.MessageTemplateUsecase.GetTemplate(ctx, id)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, found)
+}
+
+func handleUpdateTemplate(w http.ResponseWriter, r *http.Request, ctx context.Context, container *di.Container, id uuid.UUID) {
+	var input messagetemplate.UpdateTemplateInput
+	if err := httphelper.ParseJSON(r, &input); err != nil {
+		httphelper.WriteError(w, errx.ErrInvalidInput)
+		return
+	}
+	input.ID = id
+
+	updated, err := container.MessageTemplateUsecase.UpdateTemplate(ctx, &input)
+	if err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	httphelper.WriteJSON(w, http.StatusOK, updated)
+}
+
+func handleDeleteTemplate(w http.ResponseWriter, ctx context.Context, container *di.Container, id uuid.UUID) {
+	if err := container.MessageTemplateUsecase.DeleteTemplate(ctx, id); err != nil {
+		httphelper.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
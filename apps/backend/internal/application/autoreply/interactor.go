@@ -0,0 +1,221 @@
+package autoreply
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/domain/service"
+	"vt-link/backend/internal/shared/clock"
+	"vt-link/backend/internal/shared/errx"
+)
+
+type Interactor struct {
+	ruleRepo     repository.AutoReplyRuleRepository
+	settingsRepo repository.WorkspaceSettingsRepository
+	pusher       service.Pusher
+	clock        clock.Clock
+}
+
+func NewInteractor(
+	ruleRepo repository.AutoReplyRuleRepository,
+	settingsRepo repository.WorkspaceSettingsRepository,
+	pusher service.Pusher,
+	clock clock.Clock,
+) Usecase {
+	return &Interactor{
+		ruleRepo:     ruleRepo,
+		settingsRepo: settingsRepo,
+		pusher:       pusher,
+		clock:        clock,
+	}
+}
+
+func (i *Interactor) CreateRule(ctx context.Context, input *CreateRuleInput) (*model.AutoReplyRule, error) {
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = i.defaultTimezone(ctx)
+	}
+
+	rule, err := model.NewAutoReplyRule(input.Name, input.MatchType, input.Keywords, input.Replies, input.Priority, input.Window, timezone)
+	if err != nil {
+		return nil, invalidRuleError(err)
+	}
+	if input.Enabled != nil {
+		rule.Enabled = *input.Enabled
+	}
+
+	if err := i.ruleRepo.Create(ctx, rule); err != nil {
+		log.Printf("Failed to create auto-reply rule: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return rule, nil
+}
+
+func (i *Interactor) ListRules(ctx context.Context, input *ListRulesInput) ([]*model.AutoReplyRule, error) {
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100 // デフォルト100件、最大100件
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	rules, err := i.ruleRepo.List(ctx, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list auto-reply rules: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return rules, nil
+}
+
+func (i *Interactor) GetRule(ctx context.Context, id uuid.UUID) (*model.AutoReplyRule, error) {
+	rule, err := i.ruleRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find auto-reply rule: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	return rule, nil
+}
+
+func (i *Interactor) UpdateRule(ctx context.Context, input *UpdateRuleInput) (*model.AutoReplyRule, error) {
+	rule, err := i.ruleRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		log.Printf("Failed to find auto-reply rule: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	if input.Name != nil {
+		rule.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.MatchType != nil {
+		rule.MatchType = *input.MatchType
+		// fallback に変更した場合、キーワードは使われないため外す
+		if rule.MatchType == model.AutoReplyMatchFallback && input.Keywords == nil {
+			rule.Keywords = nil
+		}
+	}
+	if input.Keywords != nil {
+		rule.Keywords = *input.Keywords
+	}
+	if input.Replies != nil {
+		rule.Replies = *input.Replies
+	}
+	if input.Priority != nil {
+		rule.Priority = *input.Priority
+	}
+	if input.Enabled != nil {
+		rule.Enabled = *input.Enabled
+	}
+	if input.ClearWindow {
+		rule.Window = nil
+	} else if input.Window != nil {
+		rule.Window = input.Window
+	}
+	if input.Timezone != nil {
+		rule.Timezone = *input.Timezone
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, invalidRuleError(err)
+	}
+	rule.UpdatedAt = i.clock.Now()
+
+	if err := i.ruleRepo.Update(ctx, rule); err != nil {
+		log.Printf("Failed to update auto-reply rule: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return rule, nil
+}
+
+func (i *Interactor) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	if err := i.ruleRepo.Delete(ctx, id); err != nil {
+		log.Printf("Failed to delete auto-reply rule: %v", err)
+		return errx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (i *Interactor) TestRule(ctx context.Context, input *TestRuleInput) (*TestRuleResult, error) {
+	if input.Text == "" {
+		return nil, errx.NewAppError("INVALID_INPUT", "text is required", 400)
+	}
+	at := i.clock.Now()
+	if input.At != nil {
+		at = *input.At
+	}
+
+	rules, err := i.ruleRepo.ListEnabled(ctx)
+	if err != nil {
+		log.Printf("Failed to list enabled auto-reply rules: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	rule := model.MatchAutoReply(rules, input.Text, at)
+	if rule == nil {
+		return &TestRuleResult{}, nil
+	}
+	return &TestRuleResult{
+		Matched:  true,
+		Fallback: rule.MatchType == model.AutoReplyMatchFallback,
+		Rule:     rule,
+	}, nil
+}
+
+func (i *Interactor) HandleMessageEvent(ctx context.Context, event *model.WebhookEvent) error {
+	if event.Message == nil || event.Message.Type != model.WebhookMessageText || event.ReplyToken == "" {
+		return nil
+	}
+	// 待機中のチャネルは応答できず、再送されたイベントの応答トークンは期限切れの可能性が高い
+	if event.Mode == "standby" || event.IsRedelivery {
+		return nil
+	}
+
+	rules, err := i.ruleRepo.ListEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list enabled auto-reply rules: %w", err)
+	}
+
+	rule := model.MatchAutoReply(rules, event.Message.Text, event.Timestamp)
+	if rule == nil {
+		return nil
+	}
+
+	if err := i.pusher.Reply(ctx, event.ReplyToken, rule.Replies); err != nil {
+		return fmt.Errorf("failed to reply with auto-reply rule %s: %w", rule.ID, err)
+	}
+	return nil
+}
+
+// defaultTimezone ワークスペースの既定タイムゾーン（取得できない場合は組み込みの既定値）
+func (i *Interactor) defaultTimezone(ctx context.Context) string {
+	settings, err := i.settingsRepo.Get(ctx)
+	if err != nil {
+		log.Printf("Failed to get workspace settings: %v", err)
+		return model.DefaultWorkspaceTimezone
+	}
+	return settings.DefaultTimezone
+}
+
+// invalidRuleError ルールの検証エラーをフィールド単位のAppErrorに変換
+func invalidRuleError(err error) error {
+	fieldErrs := model.FieldErrors(err)
+	if fieldErrs == nil {
+		return errx.NewAppError("INVALID_AUTO_REPLY", err.Error(), 400)
+	}
+	fields := make([]errx.FieldError, len(fieldErrs))
+	for idx, fieldErr := range fieldErrs {
+		fields[idx] = errx.FieldError{Field: fieldErr.Field, Message: fieldErr.Message}
+	}
+	return errx.NewValidationError("INVALID_AUTO_REPLY", "Invalid auto-reply rule", fields)
+}
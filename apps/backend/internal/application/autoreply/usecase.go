@@ -0,0 +1,76 @@
+package autoreply
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+// CreateRuleInput Timezone 未指定の場合はワークスペースの既定値。Enabled 未指定の場合は有効
+type CreateRuleInput struct {
+	Name      string                   `json:"name"`
+	MatchType model.AutoReplyMatchType `json:"match_type"`
+	Keywords  model.AutoReplyKeywords  `json:"keywords"`
+	Replies   model.MessageParts       `json:"replies"`
+	Priority  int                      `json:"priority"`
+	Enabled   *bool                    `json:"enabled"`
+	Window    *model.AutoReplyWindow   `json:"window"`
+	Timezone  string                   `json:"timezone"`
+}
+
+// UpdateRuleInput 未指定（nil）のフィールドは現在の値を維持する。ClearWindow が true の場合は時間帯の指定を外す
+type UpdateRuleInput struct {
+	ID          uuid.UUID                 `json:"-"`
+	Name        *string                   `json:"name"`
+	MatchType   *model.AutoReplyMatchType `json:"match_type"`
+	Keywords    *model.AutoReplyKeywords  `json:"keywords"`
+	Replies     *model.MessageParts       `json:"replies"`
+	Priority    *int                      `json:"priority"`
+	Enabled     *bool                     `json:"enabled"`
+	Window      *model.AutoReplyWindow    `json:"window"`
+	ClearWindow bool                      `json:"clear_window"`
+	Timezone    *string                   `json:"timezone"`
+}
+
+type ListRulesInput struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// TestRuleInput At 未指定の場合は現在日時で時間帯を判定する
+type TestRuleInput struct {
+	Text string     `json:"text"`
+	At   *time.Time `json:"at"`
+}
+
+// TestRuleResult 試験照合の結果（一致するルールがない場合 Rule は nil）
+type TestRuleResult struct {
+	Matched  bool                 `json:"matched"`
+	Fallback bool                 `json:"fallback"`
+	Rule     *model.AutoReplyRule `json:"rule"`
+}
+
+type Usecase interface {
+	// CreateRule 自動応答ルールを作成
+	CreateRule(ctx context.Context, input *CreateRuleInput) (*model.AutoReplyRule, error)
+
+	// ListRules 自動応答ルール一覧を照合順で取得
+	ListRules(ctx context.Context, input *ListRulesInput) ([]*model.AutoReplyRule, error)
+
+	// GetRule 自動応答ルールを取得
+	GetRule(ctx context.Context, id uuid.UUID) (*model.AutoReplyRule, error)
+
+	// UpdateRule 自動応答ルールを更新
+	UpdateRule(ctx context.Context, input *UpdateRuleInput) (*model.AutoReplyRule, error)
+
+	// DeleteRule 自動応答ルールを削除
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+
+	// TestRule テキストを受信した場合にどのルールで応答するかを返す（実際には返信しない）
+	TestRule(ctx context.Context, input *TestRuleInput) (*TestRuleResult, error)
+
+	// HandleMessageEvent Webhookで受信したテキストメッセージに一致するルールがあれば応答する
+	HandleMessageEvent(ctx context.Context, event *model.WebhookEvent) error
+}
@@ -0,0 +1,150 @@
+package follower
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/domain/service"
+	"vt-link/backend/internal/shared/clock"
+	"vt-link/backend/internal/shared/errx"
+)
+
+var errFollowerUnfollowed = errx.NewAppError("FOLLOWER_UNFOLLOWED", "Cannot get the profile of a user who has blocked the account", 409)
+
+type Interactor struct {
+	followerRepo  repository.FollowerRepository
+	profileClient service.ProfileClient
+	clock         clock.Clock
+}
+
+func NewInteractor(
+	followerRepo repository.FollowerRepository,
+	profileClient service.ProfileClient,
+	clock clock.Clock,
+) Usecase {
+	return &Interactor{
+		followerRepo:  followerRepo,
+		profileClient: profileClient,
+		clock:         clock,
+	}
+}
+
+func (i *Interactor) ListFollowers(ctx context.Context, input *ListFollowersInput) (*model.FollowerPage, error) {
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100 // デフォルト100件、最大100件
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, errx.NewAppError("INVALID_FILTER", "status must be following or unfollowed", 400)
+	}
+
+	filter := &model.FollowerFilter{
+		Status:   input.Status,
+		Query:    input.Query,
+		Language: input.Language,
+	}
+
+	followers, err := i.followerRepo.List(ctx, filter, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list followers: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	total, err := i.followerRepo.Count(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to count followers: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return &model.FollowerPage{Followers: followers, Total: total}, nil
+}
+
+func (i *Interactor) GetFollower(ctx context.Context, id uuid.UUID) (*model.Follower, error) {
+	follower, err := i.followerRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find follower: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	return follower, nil
+}
+
+func (i *Interactor) RefreshProfile(ctx context.Context, id uuid.UUID) (*model.Follower, error) {
+	follower, err := i.followerRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find follower: %v", err)
+		return nil, errx.ErrNotFound
+	}
+	if follower.Status == model.FollowerStatusUnfollowed {
+		return nil, errFollowerUnfollowed
+	}
+
+	profile, err := i.profileClient.GetProfile(ctx, follower.LineUserID)
+	if err != nil {
+		log.Printf("Failed to get LINE profile of %s: %v", follower.LineUserID, err)
+		return nil, errx.NewAppError("PROFILE_UNAVAILABLE", "Failed to get the LINE profile", 502)
+	}
+	follower.SetProfile(profile, i.clock.Now())
+
+	if err := i.followerRepo.Save(ctx, follower); err != nil {
+		log.Printf("Failed to save follower: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return follower, nil
+}
+
+func (i *Interactor) HandleFollowEvent(ctx context.Context, event *model.WebhookEvent) error {
+	follower := i.findOrNewFollower(ctx, event)
+	if follower == nil {
+		return nil
+	}
+	if !follower.Follow(event.Timestamp) {
+		log.Printf("Skipping stale follow event %s for %s", event.WebhookEventID, follower.LineUserID)
+		return nil
+	}
+
+	// プロフィールが取得できなくても友だち追加は記録する（RefreshProfile で取得し直せる）
+	profile, err := i.profileClient.GetProfile(ctx, follower.LineUserID)
+	if err != nil {
+		log.Printf("Failed to get LINE profile of %s: %v", follower.LineUserID, err)
+	} else {
+		follower.SetProfile(profile, i.clock.Now())
+	}
+
+	return i.followerRepo.Save(ctx, follower)
+}
+
+func (i *Interactor) HandleUnfollowEvent(ctx context.Context, event *model.WebhookEvent) error {
+	follower := i.findOrNewFollower(ctx, event)
+	if follower == nil {
+		return nil
+	}
+	if !follower.Unfollow(event.Timestamp) {
+		log.Printf("Skipping stale unfollow event %s for %s", event.WebhookEventID, follower.LineUserID)
+		return nil
+	}
+
+	return i.followerRepo.Save(ctx, follower)
+}
+
+// findOrNewFollower イベントの送信元の友だちを取得（未登録の場合は新規作成、ユーザー以外からのイベントは nil）
+func (i *Interactor) findOrNewFollower(ctx context.Context, event *model.WebhookEvent) *model.Follower {
+	if event.Source == nil || event.Source.Type != model.WebhookSourceUser || event.UserID() == "" {
+		return nil
+	}
+
+	if follower, err := i.followerRepo.FindByLineUserID(ctx, event.UserID()); err == nil {
+		return follower
+	}
+	return model.NewFollower(event.UserID())
+}
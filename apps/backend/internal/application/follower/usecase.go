@@ -0,0 +1,34 @@
+package follower
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+// ListFollowersInput Query は表示名の部分一致、またはLINEユーザーIDの完全一致で検索する
+type ListFollowersInput struct {
+	Status   model.FollowerStatus `json:"status"`
+	Query    string               `json:"q"`
+	Language string               `json:"language"`
+	Limit    int                  `json:"limit"`
+	Offset   int                  `json:"offset"`
+}
+
+type Usecase interface {
+	// ListFollowers 絞り込んだ友だち一覧を取得（友だち追加の新しい順、全件数付き）
+	ListFollowers(ctx context.Context, input *ListFollowersInput) (*model.FollowerPage, error)
+
+	// GetFollower 友だちを取得
+	GetFollower(ctx context.Context, id uuid.UUID) (*model.Follower, error)
+
+	// RefreshProfile LINEからプロフィールを取得し直す（ブロック中の友だちは取得できない）
+	RefreshProfile(ctx context.Context, id uuid.UUID) (*model.Follower, error)
+
+	// HandleFollowEvent Webhookの友だち追加（ブロック解除）を反映し、プロフィールを取得する
+	HandleFollowEvent(ctx context.Context, event *model.WebhookEvent) error
+
+	// HandleUnfollowEvent Webhookのブロック（友だち解除）を反映する
+	HandleUnfollowEvent(ctx context.Context, event *model.WebhookEvent) error
+}
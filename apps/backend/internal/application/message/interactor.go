@@ -2,8 +2,10 @@ package message
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/google/uuid"
 	"vt-link/backend/internal/domain/model"
@@ -13,44 +15,148 @@ import (
 	"vt-link/backend/internal/shared/errx"
 )
 
+var errInvalidSchedule = errx.NewAppError("INVALID_SCHEDULE", "Scheduled time must be in the future", 400)
+
+var errMessageDeleted = errx.NewAppError("MESSAGE_DELETED", "Message is in the trash; restore it first", 409)
+
+// sendingTimeout 送信処理が途中で終了したとみなすまでの時間（関数の最大実行時間より十分長くする）
+const sendingTimeout = 15 * time.Minute
+
 type Interactor struct {
-	messageRepo repository.MessageRepository
-	txManager   repository.TxManager
-	pusher      service.Pusher
-	clock       clock.Clock
+	messageRepo  repository.MessageRepository
+	templateRepo repository.MessageTemplateRepository
+	tagRepo      repository.TagRepository
+	scheduleRepo repository.RecurringScheduleRepository
+	settingsRepo repository.WorkspaceSettingsRepository
+	batchRepo    repository.DeliveryBatchRepository
+	txManager    repository.TxManager
+	pusher       service.Pusher
+	clock        clock.Clock
 }
 
 func NewInteractor(
 	messageRepo repository.MessageRepository,
+	templateRepo repository.MessageTemplateRepository,
+	tagRepo repository.TagRepository,
+	scheduleRepo repository.RecurringScheduleRepository,
+	settingsRepo repository.WorkspaceSettingsRepository,
+	batchRepo repository.DeliveryBatchRepository,
 	txManager repository.TxManager,
 	pusher service.Pusher,
 	clock clock.Clock,
 ) Usecase {
 	return &Interactor{
-		messageRepo: messageRepo,
-		txManager:   txManager,
-		pusher:      pusher,
-		clock:       clock,
+		messageRepo:  messageRepo,
+		templateRepo: templateRepo,
+		tagRepo:      tagRepo,
+		scheduleRepo: scheduleRepo,
+		settingsRepo: settingsRepo,
+		batchRepo:    batchRepo,
+		txManager:    txManager,
+		pusher:       pusher,
+		clock:        clock,
 	}
 }
 
 func (i *Interactor) CreateMessage(ctx context.Context, input *CreateMessageInput) (*model.Message, error) {
-	if input.Title == "" || input.Body == "" {
+	// 単一の content と複数の parts は併用不可
+	if input.Content != nil && len(input.Parts) > 0 {
 		return nil, errx.ErrInvalidInput
 	}
 
-	message := model.NewMessage(input.Title, input.Body)
+	message, err := i.newMessage(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	message.ChangedBy = input.Actor
 
-	err := i.messageRepo.Create(ctx, message)
+	if err := message.SetContent(input.Content); err != nil {
+		return nil, invalidContentError(err)
+	}
+	if err := message.SetParts(input.Parts); err != nil {
+		return nil, invalidContentError(err)
+	}
+	if err := message.SetQuickReply(input.QuickReply); err != nil {
+		return nil, invalidContentError(err)
+	}
+	if err := message.SetTarget(input.Target); err != nil {
+		return nil, invalidContentError(err)
+	}
+	if err := message.SetNarrowcast(input.Narrowcast); err != nil {
+		return nil, invalidContentError(err)
+	}
+	if err := message.SetRecipients(input.Recipients); err != nil {
+		return nil, invalidContentError(err)
+	}
+	if err := message.Validate(); err != nil {
+		return nil, invalidContentError(err)
+	}
+
+	if len(input.TagIDs) == 0 {
+		err = i.messageRepo.Create(ctx, message)
+		if err != nil {
+			log.Printf("Failed to create message: %v", err)
+			return nil, errx.ErrInternalServer
+		}
+		return message, nil
+	}
+
+	// タグを付ける場合は割り当てと同じトランザクションで作成する
+	err = i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := i.assignTags(ctx, message, input.TagIDs); err != nil {
+			return err
+		}
+
+		if err := i.messageRepo.Create(ctx, message); err != nil {
+			log.Printf("Failed to create message: %v", err)
+			return errx.ErrInternalServer
+		}
+		if err := i.messageRepo.SetTags(ctx, message.ID, message.TagIDs()); err != nil {
+			log.Printf("Failed to set message tags: %v", err)
+			return errx.ErrInternalServer
+		}
+		return nil
+	})
 	if err != nil {
-		log.Printf("Failed to create message: %v", err)
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// newMessage 入力のタイトル・本文、またはテンプレートからメッセージを組み立てる
+func (i *Interactor) newMessage(ctx context.Context, input *CreateMessageInput) (*model.Message, error) {
+	if input.TemplateID == nil {
+		// リッチコンテンツがある場合、本文は任意（一覧表示用のメモとして扱う）
+		if input.Title == "" || (input.Body == "" && input.Content == nil && len(input.Parts) == 0) {
+			return nil, errx.ErrInvalidInput
+		}
+		return model.NewMessage(input.Title, input.Body), nil
+	}
+
+	// テンプレート指定時はタイトル・本文の直接指定と併用できない
+	if input.Title != "" || input.Body != "" {
+		return nil, errx.ErrInvalidInput
+	}
+
+	template, err := i.templateRepo.FindByID(ctx, *input.TemplateID)
+	if err != nil {
+		log.Printf("Failed to find message template: %v", err)
+		return nil, errx.NewAppError("TEMPLATE_NOT_FOUND", "Message template not found", 404)
+	}
+
+	message, err := model.NewMessageFromTemplate(template, input.Variables)
+	if err != nil {
+		if errors.Is(err, model.ErrMissingTemplateVariable) {
+			return nil, errx.NewAppError("MISSING_VARIABLES", err.Error(), 400)
+		}
 		return nil, errx.ErrInternalServer
 	}
 
 	return message, nil
 }
 
-func (i *Interactor) ListMessages(ctx context.Context, input *ListMessagesInput) ([]*model.Message, error) {
+func (i *Interactor) ListMessages(ctx context.Context, input *ListMessagesInput) (*model.MessagePage, error) {
 	limit := input.Limit
 	if limit <= 0 || limit > 100 {
 		limit = 100 // デフォルト100件、最大100件
@@ -61,13 +167,87 @@ func (i *Interactor) ListMessages(ctx context.Context, input *ListMessagesInput)
 		offset = 0
 	}
 
-	messages, err := i.messageRepo.List(ctx, limit, offset)
+	sort, err := model.ParseMessageSort(input.Sort)
+	if err != nil {
+		return nil, errx.NewAppError("INVALID_SORT", err.Error(), 400)
+	}
+	if input.Sort == "" && input.Deleted {
+		sort = model.DefaultTrashSort
+	}
+
+	filter := model.MessageFilter{
+		Deleted:         input.Deleted,
+		Statuses:        input.Statuses,
+		TagIDs:          input.TagIDs,
+		SourceMessageID: input.SourceID,
+		ScheduledFrom:   input.ScheduledFrom,
+		ScheduledTo:     input.ScheduledTo,
+		SentFrom:        input.SentFrom,
+		SentTo:          input.SentTo,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, errx.NewAppError("INVALID_FILTER", err.Error(), 400)
+	}
+
+	query := &model.MessageQuery{Filter: filter, Sort: sort, Limit: limit + 1, Offset: offset}
+	if input.Cursor != "" {
+		cursor, err := model.DecodeMessageCursor(input.Cursor, sort)
+		if err != nil {
+			return nil, errx.NewAppError("INVALID_CURSOR", err.Error(), 400)
+		}
+		query.Cursor = cursor
+		query.Offset = 0
+	}
+
+	// 次ページの有無を判定するため1件多く取得する
+	messages, err := i.messageRepo.List(ctx, query)
 	if err != nil {
 		log.Printf("Failed to list messages: %v", err)
 		return nil, errx.ErrInternalServer
 	}
 
-	return messages, nil
+	total, err := i.messageRepo.Count(ctx, &filter)
+	if err != nil {
+		log.Printf("Failed to count messages: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	page := &model.MessagePage{Messages: messages, Total: total}
+	if len(messages) > limit {
+		page.Messages = messages[:limit]
+		page.NextCursor = model.NewMessageCursor(page.Messages[limit-1], sort).Encode()
+	}
+
+	return page, nil
+}
+
+func (i *Interactor) SearchMessages(ctx context.Context, input *SearchMessagesInput) ([]*model.MessageSearchResult, error) {
+	terms := model.SearchTerms(input.Query)
+	if len(terms) == 0 {
+		return nil, errx.ErrInvalidInput
+	}
+
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100 // デフォルト100件、最大100件
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	results, err := i.messageRepo.Search(ctx, terms, limit, offset)
+	if err != nil {
+		log.Printf("Failed to search messages: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	for _, result := range results {
+		result.Highlight(terms)
+	}
+
+	return results, nil
 }
 
 func (i *Interactor) GetMessage(ctx context.Context, id uuid.UUID) (*model.Message, error) {
@@ -80,53 +260,734 @@ func (i *Interactor) GetMessage(ctx context.Context, id uuid.UUID) (*model.Messa
 	return message, nil
 }
 
-func (i *Interactor) SendMessage(ctx context.Context, input *SendMessageInput) error {
+func (i *Interactor) UpdateMessage(ctx context.Context, input *UpdateMessageInput) (*model.Message, error) {
+	if (input.Title != nil && *input.Title == "") || (input.Body != nil && *input.Body == "") {
+		return nil, errx.ErrInvalidInput
+	}
+	if input.Content != nil && len(input.Parts) > 0 {
+		return nil, errx.ErrInvalidInput
+	}
+
+	var updated *model.Message
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err := i.messageRepo.FindByID(ctx, input.ID)
+		if err != nil {
+			log.Printf("Failed to find message for update: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if message.IsDeleted() {
+			return errMessageDeleted
+		}
+		if !message.CanEdit() {
+			return errx.NewAppError("CANNOT_EDIT", fmt.Sprintf("Messages in %s status cannot be edited", message.Status), 409)
+		}
+
+		title, body := message.Title, message.Body
+		if input.Title != nil {
+			title = *input.Title
+		}
+		if input.Body != nil {
+			body = *input.Body
+		}
+		message.Edit(title, body)
+		// 配信予定日時は予約済みのメッセージだけ変更できる（下書きなどは配信予約で予約する）
+		if input.ScheduledAt != nil {
+			if message.Status != model.MessageStatusScheduled {
+				return errx.NewAppError("NOT_SCHEDULED", "Only scheduled messages can change scheduled_at; schedule the message instead", 409)
+			}
+			if !input.ScheduledAt.After(i.clock.Now()) {
+				return errInvalidSchedule
+			}
+			timezone := message.Timezone
+			if timezone == "" {
+				timezone = i.defaultTimezone(ctx)
+			}
+			if err := message.ScheduleIn(*input.ScheduledAt, timezone); err != nil {
+				return errx.NewAppError("CANNOT_SCHEDULE", err.Error(), 409)
+			}
+		}
+		if input.Content != nil {
+			if err := message.SetContent(input.Content); err != nil {
+				return invalidContentError(err)
+			}
+		}
+		// 空配列が指定された場合はパートを解除
+		if input.Parts != nil {
+			if err := message.SetParts(input.Parts); err != nil {
+				return invalidContentError(err)
+			}
+		}
+		// 空配列が指定された場合はクイックリプライを解除
+		if input.QuickReply != nil {
+			if err := message.SetQuickReply(input.QuickReply); err != nil {
+				return invalidContentError(err)
+			}
+		}
+		if input.Target != "" || input.Recipients != nil {
+			if err := i.ensureRecipientsEditable(ctx, message.ID); err != nil {
+				return err
+			}
+		}
+		if input.Target != "" {
+			if err := message.SetTarget(input.Target); err != nil {
+				return invalidContentError(err)
+			}
+		}
+		if input.Narrowcast != nil {
+			if err := message.SetNarrowcast(input.Narrowcast); err != nil {
+				return invalidContentError(err)
+			}
+		}
+		// 空配列が指定された場合は既定の送信先に戻す
+		if input.Recipients != nil {
+			if err := message.SetRecipients(input.Recipients); err != nil {
+				return invalidContentError(err)
+			}
+		}
+		if err := message.Validate(); err != nil {
+			return invalidContentError(err)
+		}
+		// 空配列が指定された場合はタグを全て外す
+		if input.TagIDs != nil {
+			if err := i.assignTags(ctx, message, input.TagIDs); err != nil {
+				return err
+			}
+		}
+
+		message.ChangedBy = input.Actor
+		err = i.messageRepo.Update(ctx, message)
+		if err != nil {
+			log.Printf("Failed to update message: %v", err)
+			return errx.ErrInternalServer
+		}
+		if input.TagIDs != nil {
+			if err := i.messageRepo.SetTags(ctx, message.ID, message.TagIDs()); err != nil {
+				log.Printf("Failed to set message tags: %v", err)
+				return errx.ErrInternalServer
+			}
+		}
+
+		updated = message
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (i *Interactor) DuplicateMessage(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	var duplicated *model.Message
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		source, err := i.messageRepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("Failed to find message for duplicate: %v", err)
+			return errx.ErrNotFound
+		}
+
+		duplicate := source.Clone()
+		if err := i.messageRepo.Create(ctx, duplicate); err != nil {
+			log.Printf("Failed to create duplicated message: %v", err)
+			return errx.ErrInternalServer
+		}
+		if len(duplicate.Tags) > 0 {
+			if err := i.messageRepo.SetTags(ctx, duplicate.ID, duplicate.TagIDs()); err != nil {
+				log.Printf("Failed to set duplicated message tags: %v", err)
+				return errx.ErrInternalServer
+			}
+		}
+
+		duplicated = duplicate
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return duplicated, nil
+}
+
+func (i *Interactor) DeleteMessage(ctx context.Context, id uuid.UUID) error {
 	return i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err := i.messageRepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("Failed to find message for delete: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if err := message.Delete(i.clock.Now()); err != nil {
+			return errx.NewAppError("CANNOT_DELETE", err.Error(), 409)
+		}
+
+		err = i.messageRepo.Update(ctx, message)
+		if err != nil {
+			log.Printf("Failed to delete message: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		return nil
+	})
+}
+
+func (i *Interactor) RestoreMessage(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	var restored *model.Message
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err := i.messageRepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("Failed to find message for restore: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if err := message.Restore(i.clock.Now()); err != nil {
+			return errx.NewAppError("NOT_DELETED", err.Error(), 409)
+		}
+
+		err = i.messageRepo.Update(ctx, message)
+		if err != nil {
+			log.Printf("Failed to restore message: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		restored = message
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+func (i *Interactor) ArchiveMessage(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	var archived *model.Message
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err := i.messageRepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("Failed to find message for archive: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if message.IsDeleted() {
+			return errMessageDeleted
+		}
+		if err := message.Archive(); err != nil {
+			return errx.NewAppError("CANNOT_ARCHIVE", err.Error(), 409)
+		}
+
+		err = i.messageRepo.Update(ctx, message)
+		if err != nil {
+			log.Printf("Failed to update message status: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		archived = message
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return archived, nil
+}
+
+func (i *Interactor) ScheduleMessage(ctx context.Context, input *ScheduleMessageInput) (*model.Message, error) {
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = i.defaultTimezone(ctx)
+	}
+	scheduledAt, err := resolveScheduledAt(input, timezone)
+	if err != nil {
+		return nil, err
+	}
+	if !scheduledAt.After(i.clock.Now()) {
+		return nil, errInvalidSchedule
+	}
+
+	var scheduled *model.Message
+	err = i.txManager.WithinTx(ctx, func(ctx context.Context) error {
 		message, err := i.messageRepo.FindByID(ctx, input.ID)
 		if err != nil {
-			log.Printf("Failed to find message for send: %v", err)
+			log.Printf("Failed to find message for schedule: %v", err)
 			return errx.ErrNotFound
 		}
 
-		if !message.CanSend() {
-			return errx.NewAppError("CANNOT_SEND", "Message cannot be sent", 400)
+		// 制限導入前に保存されたメッセージもあるため、配信予約時に改めて検証する
+		if err := message.Validate(); err != nil {
+			return invalidContentError(err)
+		}
+		if err := message.ScheduleIn(scheduledAt, timezone); err != nil {
+			return errx.NewAppError("CANNOT_SCHEDULE", err.Error(), 409)
 		}
 
-		// LINE Push送信
-		text := fmt.Sprintf("%s\n\n%s", message.Title, message.Body)
-		err = i.pusher.PushText(ctx, text)
+		message.ChangedBy = input.Actor
+		err = i.messageRepo.Update(ctx, message)
+		if err != nil {
+			log.Printf("Failed to update message schedule: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		scheduled = message
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+func (i *Interactor) UnscheduleMessage(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	var unscheduled *model.Message
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err := i.messageRepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("Failed to find message for unschedule: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if err := message.Unschedule(); err != nil {
+			return errx.NewAppError("NOT_SCHEDULED", err.Error(), 409)
+		}
+
+		err = i.messageRepo.Update(ctx, message)
+		if err != nil {
+			log.Printf("Failed to update message schedule: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		unscheduled = message
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unscheduled, nil
+}
+
+func (i *Interactor) CancelMessage(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	var canceled *model.Message
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err := i.messageRepo.FindByID(ctx, id)
 		if err != nil {
-			log.Printf("Failed to push message: %v", err)
-			message.MarkAsFailed()
-			i.messageRepo.Update(ctx, message)
-			return errx.NewAppError("PUSH_FAILED", "Failed to send message", 500)
+			log.Printf("Failed to find message for cancel: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if err := message.Cancel(); err != nil {
+			return errx.NewAppError("CANNOT_CANCEL", err.Error(), 409)
 		}
 
-		// 送信成功
-		message.MarkAsSent()
 		err = i.messageRepo.Update(ctx, message)
 		if err != nil {
 			log.Printf("Failed to update message status: %v", err)
 			return errx.ErrInternalServer
 		}
 
+		canceled = message
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return canceled, nil
+}
+
+func (i *Interactor) ListRevisions(ctx context.Context, id uuid.UUID) ([]*model.MessageRevision, error) {
+	if _, err := i.messageRepo.FindByID(ctx, id); err != nil {
+		log.Printf("Failed to find message for revisions: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	revisions, err := i.messageRepo.ListRevisions(ctx, id)
+	if err != nil {
+		log.Printf("Failed to list message revisions: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	// 新しい順に並んでいるため、次の要素が直前のリビジョン
+	for idx, revision := range revisions {
+		var prev *model.MessageRevision
+		if idx+1 < len(revisions) {
+			prev = revisions[idx+1]
+		}
+		revision.ChangedFields = revision.DiffFrom(prev)
+	}
+
+	return revisions, nil
+}
+
+func (i *Interactor) RestoreRevision(ctx context.Context, input *RestoreRevisionInput) (*model.Message, error) {
+	var restored *model.Message
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err := i.messageRepo.FindByID(ctx, input.ID)
+		if err != nil {
+			log.Printf("Failed to find message for restore: %v", err)
+			return errx.ErrNotFound
+		}
+
+		revision, err := i.messageRepo.FindRevision(ctx, input.ID, input.Revision)
+		if err != nil {
+			log.Printf("Failed to find message revision: %v", err)
+			return errx.NewAppError("REVISION_NOT_FOUND", "Message revision not found", 404)
+		}
+
+		if err := message.RestoreRevision(revision); err != nil {
+			if errors.Is(err, model.ErrMessageDeleted) {
+				return errMessageDeleted
+			}
+			return errx.NewAppError("CANNOT_RESTORE", err.Error(), 409)
+		}
+
+		message.ChangedBy = input.Actor
+		err = i.messageRepo.Update(ctx, message)
+		if err != nil {
+			log.Printf("Failed to update message: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		restored = message
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+func (i *Interactor) SendMessage(ctx context.Context, input *SendMessageInput) error {
+	// 送信中の状態と送信先のバッチはLINEへの送信前にコミットし、送信結果はバッチごとに記録する。
+	// 途中で失敗・タイムアウトしても成功したバッチの記録は残るため、再送時に二重に配信しない。
+	// 行ロックを取ってから送信中にするため、同じメッセージを同時に送信しようとしても一方は CANNOT_SEND になる
+	var message *model.Message
+	var batches []*model.DeliveryBatch
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		found, err := i.messageRepo.FindByIDForUpdate(ctx, input.ID)
+		if err != nil {
+			log.Printf("Failed to find message for send: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if err := found.MarkAsSending(); err != nil {
+			return errx.NewAppError("CANNOT_SEND", "Message cannot be sent", 400)
+		}
+
+		if found.Target != model.DeliveryTargetBroadcast && found.Target != model.DeliveryTargetNarrowcast && len(found.Recipients) > 0 {
+			batches, err = i.prepareMulticast(ctx, found)
+			if err != nil {
+				log.Printf("Failed to prepare delivery batches: %v", err)
+				return errx.ErrInternalServer
+			}
+		}
+
+		if err := i.messageRepo.Update(ctx, found); err != nil {
+			log.Printf("Failed to update message status: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		message = found
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var deliveryErr error
+	switch {
+	case message.Target == model.DeliveryTargetBroadcast:
+		deliveryErr = i.broadcast(ctx, message)
+	case message.Target == model.DeliveryTargetNarrowcast:
+		deliveryErr = i.narrowcast(ctx, message)
+	case batches != nil:
+		deliveryErr = i.multicast(ctx, message, batches)
+	default:
+		// LINE Push送信
+		deliveryErr = i.pusher.PushMessage(ctx, message)
+	}
+	// 送信後にリクエストがキャンセルされても結果は記録する
+	recordCtx := context.WithoutCancel(ctx)
+	if deliveryErr != nil {
+		log.Printf("Failed to push message: %v", deliveryErr)
+		if err := message.MarkAsFailed(); err == nil {
+			if err := i.messageRepo.Update(recordCtx, message); err != nil {
+				log.Printf("Failed to record message failure: %v", err)
+				return errx.ErrInternalServer
+			}
+		}
+		if errors.Is(deliveryErr, model.ErrQuotaExceeded) {
+			return errx.NewAppError("QUOTA_EXCEEDED", deliveryErr.Error(), 409)
+		}
+		return errx.NewAppError("PUSH_FAILED", "Failed to send message", 500)
+	}
+
+	// 絞り込み配信は送信中のまま、スケジューラが進捗を確認して完了を反映する
+	if message.Target == model.DeliveryTargetNarrowcast {
+		return nil
+	}
+
+	// 送信成功
+	if err := message.MarkAsSent(); err != nil {
+		log.Printf("Failed to mark message as sent: %v", err)
+		return errx.ErrInternalServer
+	}
+	if err := i.messageRepo.Update(recordCtx, message); err != nil {
+		log.Printf("Failed to update message status: %v", err)
+		return errx.ErrInternalServer
+	}
+
+	return nil
+}
+
+// prepareMulticast 送信先をバッチに分けて作成する。
+// 再送時は前回作成したバッチをそのまま使い、同じ再送キーで送る
+func (i *Interactor) prepareMulticast(ctx context.Context, message *model.Message) ([]*model.DeliveryBatch, error) {
+	batches, err := i.batchRepo.ListByMessage(ctx, message.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery batches: %w", err)
+	}
+	if len(batches) == 0 {
+		batches = model.NewDeliveryBatches(message.ID, message.Recipients, i.clock.Now())
+		if err := i.batchRepo.CreateBatches(ctx, batches); err != nil {
+			return nil, fmt.Errorf("failed to create delivery batches: %w", err)
+		}
+	}
+	return batches, nil
+}
+
+// multicast 成功していないバッチを送信し、結果をバッチごとに記録する
+func (i *Interactor) multicast(ctx context.Context, message *model.Message, batches []*model.DeliveryBatch) error {
+	failed := 0
+	for _, batch := range batches {
+		if batch.IsSucceeded() {
+			continue
+		}
+		// バッチIDを再送キーにして、LINEが受け付け済みのバッチを二重に配信しない
+		requestID, err := i.pusher.Multicast(ctx, message, batch.Recipients, batch.ID)
+		if err != nil {
+			log.Printf("Failed to multicast batch %d of message %s: %v", batch.Index, message.ID, err)
+			batch.MarkAsFailed(err.Error(), i.clock.Now())
+			failed++
+		} else {
+			batch.MarkAsSucceeded(requestID, i.clock.Now())
+		}
+		if err := i.batchRepo.Update(context.WithoutCancel(ctx), batch); err != nil {
+			return fmt.Errorf("failed to record delivery batch: %w", err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d delivery batches failed", failed, len(batches))
+	}
+	return nil
+}
+
+// broadcast 友だち全員への送信を1つのバッチとして送信・記録する。
+// 送信前に届く友だち数の見込みが今月の残り送信数に収まるか確認する
+func (i *Interactor) broadcast(ctx context.Context, message *model.Message) error {
+	batches, err := i.batchRepo.ListByMessage(ctx, message.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list delivery batches: %w", err)
+	}
+
+	var batch *model.DeliveryBatch
+	if len(batches) > 0 {
+		batch = batches[0]
+		if batch.IsSucceeded() {
+			return nil
+		}
+	} else {
+		audience, err := i.pusher.CountReachableFollowers(ctx)
+		if err != nil {
+			// 集計前（開設直後など）は見込み数なしで送信し、上限の判定はLINE側に任せる
+			log.Printf("Failed to count reachable followers: %v", err)
+		}
+		batch = model.NewBroadcastBatch(message.ID, audience, i.clock.Now())
+	}
+
+	quota, err := i.pusher.GetQuota(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get message quota: %w", err)
+	}
+	if err := quota.Reserve(batch.Audience); err != nil {
+		return err
+	}
+
+	if len(batches) == 0 {
+		if err := i.batchRepo.CreateBatches(ctx, []*model.DeliveryBatch{batch}); err != nil {
+			return fmt.Errorf("failed to create delivery batches: %w", err)
+		}
+	}
+
+	requestID, sendErr := i.pusher.Broadcast(ctx, message, batch.ID)
+	if sendErr != nil {
+		batch.MarkAsFailed(sendErr.Error(), i.clock.Now())
+	} else {
+		batch.MarkAsSucceeded(requestID, i.clock.Now())
+	}
+	if err := i.batchRepo.Update(context.WithoutCancel(ctx), batch); err != nil {
+		return fmt.Errorf("failed to record delivery batch: %w", err)
+	}
+	return sendErr
+}
+
+// narrowcast 絞り込み配信を依頼し、LINEが受け付けたリクエストIDを記録する。
+// 受け付け後に失敗した配信を再送する場合は、別の再送キーになるよう新しいバッチを作る
+func (i *Interactor) narrowcast(ctx context.Context, message *model.Message) error {
+	batches, err := i.batchRepo.ListByMessage(ctx, message.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list delivery batches: %w", err)
+	}
+
+	var batch *model.DeliveryBatch
+	if len(batches) > 0 {
+		batch = batches[len(batches)-1]
+	}
+	if batch == nil || batch.RequestID != "" {
+		batch = model.NewNarrowcastBatch(message.ID, i.clock.Now())
+		batch.Index = len(batches)
+		if err := i.batchRepo.CreateBatches(ctx, []*model.DeliveryBatch{batch}); err != nil {
+			return fmt.Errorf("failed to create delivery batches: %w", err)
+		}
+	}
+
+	requestID, sendErr := i.pusher.Narrowcast(ctx, message, batch.ID)
+	if sendErr != nil {
+		batch.MarkAsFailed(sendErr.Error(), i.clock.Now())
+	} else {
+		batch.MarkAsAccepted(requestID, i.clock.Now())
+	}
+	if err := i.batchRepo.Update(context.WithoutCancel(ctx), batch); err != nil {
+		return fmt.Errorf("failed to record delivery batch: %w", err)
+	}
+	return sendErr
 }
 
-func (i *Interactor) RunScheduler(ctx context.Context, input *SchedulerInput) (int, error) {
+// pollNarrowcasts 送信中の絞り込み配信の進捗を確認し、完了したものをメッセージに反映する。
+// 戻り値は送信済みになったメッセージ数（失敗で完了したものは含めない）
+func (i *Interactor) pollNarrowcasts(ctx context.Context, limit int) int {
+	batches, err := i.batchRepo.FindAccepted(ctx, limit)
+	if err != nil {
+		log.Printf("Failed to find accepted narrowcasts: %v", err)
+		return 0
+	}
+
+	completed, sent := 0, 0
+	for _, batch := range batches {
+		progress, err := i.pusher.GetNarrowcastProgress(ctx, batch.RequestID)
+		if err != nil {
+			log.Printf("Failed to get narrowcast progress %s: %v", batch.RequestID, err)
+			continue
+		}
+		if !progress.IsDone() {
+			continue
+		}
+
+		var status model.MessageStatus
+		err = i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			message, err := i.messageRepo.FindByIDForUpdate(ctx, batch.MessageID)
+			if err != nil {
+				return fmt.Errorf("failed to find message: %w", err)
+			}
+
+			batch.Complete(progress, i.clock.Now())
+			if err := i.batchRepo.Update(ctx, batch); err != nil {
+				return err
+			}
+			if err := message.CompleteNarrowcast(progress); err != nil {
+				return err
+			}
+			status = message.Status
+			return i.messageRepo.Update(ctx, message)
+		})
+		if err != nil {
+			log.Printf("Failed to complete narrowcast %s: %v", batch.RequestID, err)
+			continue
+		}
+		completed++
+		if status == model.MessageStatusSent {
+			sent++
+		}
+	}
+
+	if len(batches) > 0 {
+		log.Printf("Scheduler completed %d of %d narrowcasts in progress, %d sent", completed, len(batches), sent)
+	}
+	return sent
+}
+
+func (i *Interactor) GetDeliveryUsage(ctx context.Context) (*model.DeliveryUsage, error) {
+	quota, err := i.pusher.GetQuota(ctx)
+	if err != nil {
+		log.Printf("Failed to get message quota: %v", err)
+		return nil, errx.NewAppError("QUOTA_UNAVAILABLE", "Failed to get message quota from LINE", 502)
+	}
+
+	// 送信数は月初にリセットされる
+	loc, err := model.LoadTimezone(i.defaultTimezone(ctx))
+	if err != nil {
+		loc = time.UTC
+	}
+	now := i.clock.Now().In(loc)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+
+	byTarget, err := i.batchRepo.SumAudience(ctx, monthStart)
+	if err != nil {
+		log.Printf("Failed to sum delivery audience: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return &model.DeliveryUsage{Quota: quota, ByTarget: byTarget}, nil
+}
+
+func (i *Interactor) ListDeliveries(ctx context.Context, id uuid.UUID) ([]*model.DeliveryBatch, error) {
+	if _, err := i.messageRepo.FindByID(ctx, id); err != nil {
+		log.Printf("Failed to find message for deliveries: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	batches, err := i.batchRepo.ListByMessage(ctx, id)
+	if err != nil {
+		log.Printf("Failed to list delivery batches: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return batches, nil
+}
+
+// ensureRecipientsEditable 送信を試みた後は、再送で未送信のユーザーだけに届くよう送信対象・送信先を固定する
+func (i *Interactor) ensureRecipientsEditable(ctx context.Context, messageID uuid.UUID) error {
+	batches, err := i.batchRepo.ListByMessage(ctx, messageID)
+	if err != nil {
+		log.Printf("Failed to list delivery batches: %v", err)
+		return errx.ErrInternalServer
+	}
+	if len(batches) > 0 {
+		return errx.NewAppError("RECIPIENTS_LOCKED", "Target and recipients cannot be changed after a delivery attempt", 409)
+	}
+	return nil
+}
+
+func (i *Interactor) RunScheduler(ctx context.Context, input *SchedulerInput) (*SchedulerResult, error) {
 	limit := input.Limit
 	if limit <= 0 || limit > 50 {
 		limit = 50 // デフォルト50件、最大50件（Vercel Functions のタイムアウト対策）
 	}
 
+	// 繰り返し配信を今回分の予約メッセージに展開してから、通常の予約配信として送信する
+	i.expandRecurringSchedules(ctx, input.Now, limit)
+	result := &SchedulerResult{Sent: i.pollNarrowcasts(ctx, limit)}
+	i.recoverStuckSending(ctx, input.Now, limit)
+
 	messages, err := i.messageRepo.FindScheduledMessages(ctx, input.Now, limit)
 	if err != nil {
 		log.Printf("Failed to find scheduled messages: %v", err)
-		return 0, errx.ErrInternalServer
+		return nil, errx.ErrInternalServer
 	}
 
-	sentCount := 0
 	for _, message := range messages {
 		sendInput := &SendMessageInput{ID: message.ID}
 		err := i.SendMessage(ctx, sendInput)
@@ -134,9 +995,204 @@ func (i *Interactor) RunScheduler(ctx context.Context, input *SchedulerInput) (i
 			log.Printf("Failed to send scheduled message %s: %v", message.ID, err)
 			continue
 		}
-		sentCount++
+		// 絞り込み配信は受け付けられただけで、送信済みになるのは進捗の確認後
+		if message.Target == model.DeliveryTargetNarrowcast {
+			result.Accepted++
+			continue
+		}
+		result.Sent++
 	}
 
-	log.Printf("Scheduler processed %d messages, sent %d successfully", len(messages), sentCount)
-	return sentCount, nil
+	log.Printf("Scheduler processed %d messages, sent %d, %d narrowcasts accepted", len(messages), result.Sent, result.Accepted)
+	return result, nil
+}
+
+// recoverStuckSending 送信中のまま sendingTimeout を過ぎたメッセージ（送信処理が途中で終了したもの）を失敗にする。
+// 失敗にしたメッセージは再送でき、作成済みのバッチと同じ再送キーで未送信の分だけ送る
+func (i *Interactor) recoverStuckSending(ctx context.Context, now time.Time, limit int) int {
+	messages, err := i.messageRepo.FindStuckSending(ctx, now.Add(-sendingTimeout), limit)
+	if err != nil {
+		log.Printf("Failed to find stuck sending messages: %v", err)
+		return 0
+	}
+
+	recovered := 0
+	for _, stuck := range messages {
+		marked := false
+		err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			message, err := i.messageRepo.FindByIDForUpdate(ctx, stuck.ID)
+			if err != nil {
+				return fmt.Errorf("failed to find message: %w", err)
+			}
+			// ロックを待つ間に送信結果が記録された場合はそのままにする
+			if message.Status != model.MessageStatusSending {
+				return nil
+			}
+			if err := message.MarkAsFailed(); err != nil {
+				return err
+			}
+			marked = true
+			return i.messageRepo.Update(ctx, message)
+		})
+		if err != nil {
+			log.Printf("Failed to recover stuck message %s: %v", stuck.ID, err)
+			continue
+		}
+		if marked {
+			recovered++
+		}
+	}
+
+	if recovered > 0 {
+		log.Printf("Scheduler marked %d stuck sending messages as failed", recovered)
+	}
+	return recovered
+}
+
+// expandRecurringSchedules 期限が到来した繰り返し配信ごとに雛形を複製した予約メッセージを作成し、次回日時へ進める
+func (i *Interactor) expandRecurringSchedules(ctx context.Context, now time.Time, limit int) int {
+	schedules, err := i.scheduleRepo.FindDueSchedules(ctx, now, limit)
+	if err != nil {
+		log.Printf("Failed to find due recurring schedules: %v", err)
+		return 0
+	}
+
+	expanded := 0
+	for _, schedule := range schedules {
+		skipped := false
+		err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			source, err := i.messageRepo.FindByID(ctx, schedule.MessageID)
+			if err != nil {
+				return fmt.Errorf("failed to find source message: %w", err)
+			}
+			// 読み込んだ後に停止・変更された場合や、同時に実行されたスケジューラが先に処理した場合は何もしない
+			runAt := *schedule.NextRunAt
+
+			// 雛形がゴミ箱に移された場合は繰り返し配信を停止する（復元後に再開できる）
+			if source.IsDeleted() {
+				if err := schedule.Pause(); err != nil {
+					return err
+				}
+				paused, err := i.scheduleRepo.PauseIfDue(ctx, schedule, runAt)
+				skipped = err == nil && !paused
+				return err
+			}
+
+			// 先に次回日時を進め、既に進められていた場合は同じ回を二重に作らない
+			if err := schedule.Advance(now); err != nil {
+				return err
+			}
+			advanced, err := i.scheduleRepo.AdvanceIfDue(ctx, schedule, runAt)
+			if err != nil {
+				return err
+			}
+			if !advanced {
+				skipped = true
+				return nil
+			}
+
+			occurrence := source.Clone()
+			if err := occurrence.ScheduleIn(runAt, schedule.Timezone); err != nil {
+				return err
+			}
+			if err := i.messageRepo.Create(ctx, occurrence); err != nil {
+				return err
+			}
+			if len(occurrence.Tags) > 0 {
+				if err := i.messageRepo.SetTags(ctx, occurrence.ID, occurrence.TagIDs()); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("Failed to expand recurring schedule %s: %v", schedule.ID, err)
+			continue
+		}
+		if skipped {
+			continue
+		}
+		expanded++
+	}
+
+	if len(schedules) > 0 {
+		log.Printf("Scheduler expanded %d of %d recurring schedules", expanded, len(schedules))
+	}
+	return expanded
+}
+
+// assignTags 指定されたタグが全て存在することを確認してメッセージに設定
+func (i *Interactor) assignTags(ctx context.Context, message *model.Message, tagIDs []uuid.UUID) error {
+	tags := []*model.Tag{}
+	if len(tagIDs) > 0 {
+		found, err := i.tagRepo.FindByIDs(ctx, tagIDs)
+		if err != nil {
+			log.Printf("Failed to find tags: %v", err)
+			return errx.ErrInternalServer
+		}
+		byID := make(map[uuid.UUID]*model.Tag, len(found))
+		for _, tag := range found {
+			byID[tag.ID] = tag
+		}
+		for _, id := range tagIDs {
+			tag, ok := byID[id]
+			if !ok {
+				return errx.NewAppError("TAG_NOT_FOUND", fmt.Sprintf("Tag %s not found", id), 404)
+			}
+			tags = append(tags, tag)
+		}
+	}
+
+	if err := message.SetTags(tags); err != nil {
+		return errx.NewAppError("INVALID_TAG", err.Error(), 400)
+	}
+	return nil
+}
+
+// resolveScheduledAt オフセット付きの日時、またはタイムゾーンのローカル日時から配信日時を決定
+func resolveScheduledAt(input *ScheduleMessageInput, timezone string) (time.Time, error) {
+	loc, err := model.LoadTimezone(timezone)
+	if err != nil {
+		return time.Time{}, errx.NewAppError("INVALID_TIMEZONE", err.Error(), 400)
+	}
+
+	if input.LocalTime == "" {
+		if input.ScheduledAt.IsZero() {
+			return time.Time{}, errx.ErrInvalidInput
+		}
+		return input.ScheduledAt, nil
+	}
+
+	// 両方指定された場合はどちらを優先すべきか曖昧なため不可
+	if !input.ScheduledAt.IsZero() {
+		return time.Time{}, errx.ErrInvalidInput
+	}
+	scheduledAt, err := model.ParseLocalTime(input.LocalTime, loc)
+	if err != nil {
+		return time.Time{}, errx.NewAppError("INVALID_LOCAL_TIME", err.Error(), 400)
+	}
+	return scheduledAt, nil
+}
+
+// defaultTimezone ワークスペースの既定タイムゾーン（取得できない場合は組み込みの既定値）
+func (i *Interactor) defaultTimezone(ctx context.Context) string {
+	settings, err := i.settingsRepo.Get(ctx)
+	if err != nil {
+		log.Printf("Failed to get workspace settings: %v", err)
+		return model.DefaultWorkspaceTimezone
+	}
+	return settings.DefaultTimezone
+}
+
+// invalidContentError ドメインのコンテンツ検証エラーをフィールド単位のAppErrorに変換
+func invalidContentError(err error) error {
+	fieldErrs := model.FieldErrors(err)
+	if fieldErrs == nil {
+		return errx.NewAppError("INVALID_CONTENT", err.Error(), 400)
+	}
+	fields := make([]errx.FieldError, len(fieldErrs))
+	for idx, fieldErr := range fieldErrs {
+		fields[idx] = errx.FieldError{Field: fieldErr.Field, Message: fieldErr.Message}
+	}
+	return errx.NewValidationError("INVALID_CONTENT", err.Error(), fields)
 }
@@ -8,14 +8,80 @@ import (
 	"vt-link/backend/internal/domain/model"
 )
 
+// CreateMessageInput TemplateID を指定した場合、タイトル・本文の代わりにテンプレートへ Variables を埋め込んで作成する
 type CreateMessageInput struct {
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	Title      string                  `json:"title"`
+	Body       string                  `json:"body"`
+	Content    *model.MessageContent   `json:"content"`
+	Parts      model.MessageParts      `json:"parts"`
+	QuickReply model.QuickReplyItems   `json:"quick_reply"`
+	Target     model.DeliveryTarget    `json:"target"`
+	Recipients model.Recipients        `json:"recipients"`
+	Narrowcast *model.NarrowcastFilter `json:"narrowcast"`
+	TemplateID *uuid.UUID              `json:"template_id"`
+	Variables  map[string]string       `json:"variables"`
+	TagIDs     []uuid.UUID             `json:"tag_ids"`
+	// Actor 操作者（リビジョンの変更者として記録する）
+	Actor string `json:"-"`
 }
 
+// UpdateMessageInput 未指定（nil）のフィールドは現在の値を維持する
+type UpdateMessageInput struct {
+	ID          uuid.UUID               `json:"-"`
+	Title       *string                 `json:"title"`
+	Body        *string                 `json:"body"`
+	Content     *model.MessageContent   `json:"content"`
+	Parts       model.MessageParts      `json:"parts"`
+	QuickReply  model.QuickReplyItems   `json:"quick_reply"`
+	Target      model.DeliveryTarget    `json:"target"`
+	Recipients  model.Recipients        `json:"recipients"`
+	Narrowcast  *model.NarrowcastFilter `json:"narrowcast"`
+	ScheduledAt *time.Time              `json:"scheduled_at"`
+	TagIDs      []uuid.UUID             `json:"tag_ids"`
+	// Actor 操作者（リビジョンの変更者として記録する）
+	Actor string `json:"-"`
+}
+
+// ScheduleMessageInput 配信日時は ScheduledAt（オフセット付きの日時）か LocalTime のどちらかで指定する
+type ScheduleMessageInput struct {
+	ID          uuid.UUID `json:"-"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	// LocalTime Timezone におけるローカル日時（例: "2024-01-05T20:00"）
+	LocalTime string `json:"local_time"`
+	// Timezone IANA タイムゾーン（例: "Asia/Tokyo"）。未指定の場合はワークスペースの既定値
+	Timezone string `json:"timezone"`
+	// Actor 操作者（リビジョンの変更者として記録する）
+	Actor string `json:"-"`
+}
+
+type RestoreRevisionInput struct {
+	ID       uuid.UUID `json:"-"`
+	Revision int       `json:"-"`
+	Actor    string    `json:"-"`
+}
+
+// ListMessagesInput Cursor は前のページの next_cursor。指定した場合 Offset は無視する。
+// Deleted を指定するとゴミ箱の一覧（既定は削除日時の新しい順）を返す
 type ListMessagesInput struct {
-	Limit  int `json:"limit"`
-	Offset int `json:"offset"`
+	Deleted       bool                  `json:"-"`
+	Limit         int                   `json:"limit"`
+	Offset        int                   `json:"offset"`
+	Cursor        string                `json:"cursor"`
+	Sort          string                `json:"sort"`
+	Statuses      []model.MessageStatus `json:"status"`
+	TagIDs        []uuid.UUID           `json:"tag"`
+	SourceID      *uuid.UUID            `json:"source_id"`
+	ScheduledFrom *time.Time            `json:"scheduled_from"`
+	ScheduledTo   *time.Time            `json:"scheduled_to"`
+	SentFrom      *time.Time            `json:"sent_from"`
+	SentTo        *time.Time            `json:"sent_to"`
+}
+
+// SearchMessagesInput Query は空白区切りの検索語（全ての語を含むメッセージがヒットする）
+type SearchMessagesInput struct {
+	Query  string `json:"q"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
 }
 
 type SendMessageInput struct {
@@ -27,19 +93,67 @@ type SchedulerInput struct {
 	Limit int       `json:"limit"`
 }
 
+// SchedulerResult Sent は送信済みになったメッセージ数。Accepted はLINEが受け付けて送信中のままの
+// 絞り込み配信で、完了は後の実行で進捗を確認して Sent に数える
+type SchedulerResult struct {
+	Sent     int `json:"sent"`
+	Accepted int `json:"accepted"`
+}
+
 type Usecase interface {
 	// CreateMessage メッセージを作成
 	CreateMessage(ctx context.Context, input *CreateMessageInput) (*model.Message, error)
 
-	// ListMessages メッセージ一覧を取得
-	ListMessages(ctx context.Context, input *ListMessagesInput) ([]*model.Message, error)
+	// ListMessages 絞り込み・並び替えたメッセージ一覧を取得（全件数と次ページのカーソル付き）
+	ListMessages(ctx context.Context, input *ListMessagesInput) (*model.MessagePage, error)
+
+	// SearchMessages タイトル・本文を検索（関連度順、一致箇所をハイライトした抜粋付き）
+	SearchMessages(ctx context.Context, input *SearchMessagesInput) ([]*model.MessageSearchResult, error)
 
 	// GetMessage メッセージを取得
 	GetMessage(ctx context.Context, id uuid.UUID) (*model.Message, error)
 
-	// SendMessage 即時送信
+	// UpdateMessage メッセージを編集（下書き・予約済み・失敗のみ）
+	UpdateMessage(ctx context.Context, input *UpdateMessageInput) (*model.Message, error)
+
+	// DuplicateMessage 内容・タグを複製した下書きを作成（配信予約・送信日時は引き継がず、複製元を記録する）
+	DuplicateMessage(ctx context.Context, id uuid.UUID) (*model.Message, error)
+
+	// DeleteMessage ゴミ箱に移す（送信中は不可）。ゴミ箱にある予約済みメッセージは配信されない
+	DeleteMessage(ctx context.Context, id uuid.UUID) error
+
+	// RestoreMessage ゴミ箱から戻す（予約日時を過ぎていた場合は下書きに戻す）
+	RestoreMessage(ctx context.Context, id uuid.UUID) (*model.Message, error)
+
+	// ArchiveMessage アーカイブ（予約済み・送信中は不可）
+	ArchiveMessage(ctx context.Context, id uuid.UUID) (*model.Message, error)
+
+	// ScheduleMessage 配信予約（過去日時は不可）
+	ScheduleMessage(ctx context.Context, input *ScheduleMessageInput) (*model.Message, error)
+
+	// UnscheduleMessage 配信予約を解除して下書きに戻す
+	UnscheduleMessage(ctx context.Context, id uuid.UUID) (*model.Message, error)
+
+	// CancelMessage 配信を中止（送信前のみ）
+	CancelMessage(ctx context.Context, id uuid.UUID) (*model.Message, error)
+
+	// ListRevisions 変更履歴を取得（新しい順、直前のリビジョンからの変更項目付き）
+	ListRevisions(ctx context.Context, id uuid.UUID) ([]*model.MessageRevision, error)
+
+	// RestoreRevision 過去のリビジョンの内容を下書きとして復元
+	RestoreRevision(ctx context.Context, input *RestoreRevisionInput) (*model.Message, error)
+
+	// SendMessage 即時送信（送信先がある場合は500ユーザーずつマルチキャストし、再送時は失敗したバッチだけを送る。
+	// ブロードキャストは1回の送信として扱い、今月の残り送信数を超える場合は送らない。
+	// 絞り込み配信は送信中のまま、スケジューラが進捗を確認して完了と送信数を反映する）
 	SendMessage(ctx context.Context, input *SendMessageInput) error
 
-	// RunScheduler スケジューラ実行（スケジュール済み配信の処理）
-	RunScheduler(ctx context.Context, input *SchedulerInput) (int, error)
+	// ListDeliveries 送信バッチごとの結果を取得
+	ListDeliveries(ctx context.Context, id uuid.UUID) ([]*model.DeliveryBatch, error)
+
+	// GetDeliveryUsage 今月の送信数の上限・使用数と、送信対象別の送信数を取得
+	GetDeliveryUsage(ctx context.Context) (*model.DeliveryUsage, error)
+
+	// RunScheduler スケジューラ実行（スケジュール済み配信と、送信中の絞り込み配信の進捗確認）
+	RunScheduler(ctx context.Context, input *SchedulerInput) (*SchedulerResult, error)
 }
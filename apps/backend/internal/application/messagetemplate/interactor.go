@@ -0,0 +1,123 @@
+package messagetemplate
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/shared/errx"
+)
+
+type Interactor struct {
+	templateRepo repository.MessageTemplateRepository
+	txManager    repository.TxManager
+}
+
+func NewInteractor(
+	templateRepo repository.MessageTemplateRepository,
+	txManager repository.TxManager,
+) Usecase {
+	return &Interactor{
+		templateRepo: templateRepo,
+		txManager:    txManager,
+	}
+}
+
+func (i *Interactor) CreateTemplate(ctx context.Context, input *CreateTemplateInput) (*model.MessageTemplate, error) {
+	if input.Name == "" || input.Title == "" || input.Body == "" {
+		return nil, errx.ErrInvalidInput
+	}
+
+	template := model.NewMessageTemplate(input.Name, input.Title, input.Body)
+
+	err := i.templateRepo.Create(ctx, template)
+	if err != nil {
+		log.Printf("Failed to create message template: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return template, nil
+}
+
+func (i *Interactor) ListTemplates(ctx context.Context, input *ListTemplatesInput) ([]*model.MessageTemplate, error) {
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100 // デフォルト100件、最大100件
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	templates, err := i.templateRepo.List(ctx, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list message templates: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return templates, nil
+}
+
+func (i *Interactor) GetTemplate(ctx context.Context, id uuid.UUID) (*model.MessageTemplate, error) {
+	template, err := i.templateRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find message template: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	return template, nil
+}
+
+func (i *Interactor) UpdateTemplate(ctx context.Context, input *UpdateTemplateInput) (*model.MessageTemplate, error) {
+	if (input.Name != nil && *input.Name == "") || (input.Title != nil && *input.Title == "") || (input.Body != nil && *input.Body == "") {
+		return nil, errx.ErrInvalidInput
+	}
+
+	var updated *model.MessageTemplate
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		template, err := i.templateRepo.FindByID(ctx, input.ID)
+		if err != nil {
+			log.Printf("Failed to find message template for update: %v", err)
+			return errx.ErrNotFound
+		}
+
+		name, title, body := template.Name, template.Title, template.Body
+		if input.Name != nil {
+			name = *input.Name
+		}
+		if input.Title != nil {
+			title = *input.Title
+		}
+		if input.Body != nil {
+			body = *input.Body
+		}
+		template.Edit(name, title, body)
+
+		err = i.templateRepo.Update(ctx, template)
+		if err != nil {
+			log.Printf("Failed to update message template: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		updated = template
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (i *Interactor) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	err := i.templateRepo.Delete(ctx, id)
+	if err != nil {
+		log.Printf("Failed to delete message template: %v", err)
+		return errx.ErrNotFound
+	}
+
+	return nil
+}
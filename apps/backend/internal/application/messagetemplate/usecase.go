@@ -0,0 +1,44 @@
+package messagetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type CreateTemplateInput struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// UpdateTemplateInput 未指定（nil）のフィールドは現在の値を維持する
+type UpdateTemplateInput struct {
+	ID    uuid.UUID `json:"-"`
+	Name  *string   `json:"name"`
+	Title *string   `json:"title"`
+	Body  *string   `json:"body"`
+}
+
+type ListTemplatesInput struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+type Usecase interface {
+	// CreateTemplate テンプレートを作成
+	CreateTemplate(ctx context.Context, input *CreateTemplateInput) (*model.MessageTemplate, error)
+
+	// ListTemplates テンプレート一覧を取得
+	ListTemplates(ctx context.Context, input *ListTemplatesInput) ([]*model.MessageTemplate, error)
+
+	// GetTemplate テンプレートを取得
+	GetTemplate(ctx context.Context, id uuid.UUID) (*model.MessageTemplate, error)
+
+	// UpdateTemplate テンプレートを編集
+	UpdateTemplate(ctx context.Context, input *UpdateTemplateInput) (*model.MessageTemplate, error)
+
+	// DeleteTemplate テンプレートを削除（作成済みメッセージには影響しない）
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+}
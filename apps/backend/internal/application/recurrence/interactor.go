@@ -0,0 +1,205 @@
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/shared/clock"
+	"vt-link/backend/internal/shared/errx"
+)
+
+// プレビュー件数の既定値・上限
+const (
+	defaultPreviewCount = 10
+	maxPreviewCount     = 100
+)
+
+type Interactor struct {
+	scheduleRepo repository.RecurringScheduleRepository
+	messageRepo  repository.MessageRepository
+	settingsRepo repository.WorkspaceSettingsRepository
+	txManager    repository.TxManager
+	clock        clock.Clock
+}
+
+func NewInteractor(
+	scheduleRepo repository.RecurringScheduleRepository,
+	messageRepo repository.MessageRepository,
+	settingsRepo repository.WorkspaceSettingsRepository,
+	txManager repository.TxManager,
+	clock clock.Clock,
+) Usecase {
+	return &Interactor{
+		scheduleRepo: scheduleRepo,
+		messageRepo:  messageRepo,
+		settingsRepo: settingsRepo,
+		txManager:    txManager,
+		clock:        clock,
+	}
+}
+
+func (i *Interactor) CreateSchedule(ctx context.Context, input *CreateScheduleInput) (*model.RecurringSchedule, error) {
+	if input.MessageID == uuid.Nil || input.Expression == "" {
+		return nil, errx.ErrInvalidInput
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = i.defaultTimezone(ctx)
+	}
+	var startsAt time.Time
+	if input.StartsAt != nil {
+		startsAt = *input.StartsAt
+	}
+	schedule, err := model.NewRecurringSchedule(input.MessageID, input.Expression, timezone, startsAt, i.clock.Now())
+	if err != nil {
+		return nil, invalidRecurrenceError(err)
+	}
+
+	if _, err := i.messageRepo.FindByID(ctx, input.MessageID); err != nil {
+		log.Printf("Failed to find message for recurring schedule: %v", err)
+		return nil, errx.NewAppError("MESSAGE_NOT_FOUND", "Message not found", 404)
+	}
+
+	err = i.scheduleRepo.Create(ctx, schedule)
+	if err != nil {
+		log.Printf("Failed to create recurring schedule: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return schedule, nil
+}
+
+func (i *Interactor) ListSchedules(ctx context.Context, input *ListSchedulesInput) ([]*model.RecurringSchedule, error) {
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100 // デフォルト100件、最大100件
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	schedules, err := i.scheduleRepo.List(ctx, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list recurring schedules: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return schedules, nil
+}
+
+func (i *Interactor) GetSchedule(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error) {
+	schedule, err := i.scheduleRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find recurring schedule: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	return schedule, nil
+}
+
+func (i *Interactor) DeleteSchedule(ctx context.Context, id uuid.UUID) error {
+	err := i.scheduleRepo.Delete(ctx, id)
+	if err != nil {
+		log.Printf("Failed to delete recurring schedule: %v", err)
+		return errx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (i *Interactor) PauseSchedule(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error) {
+	return i.updateSchedule(ctx, id, func(schedule *model.RecurringSchedule) error {
+		if err := schedule.Pause(); err != nil {
+			return errx.NewAppError("ALREADY_PAUSED", err.Error(), 409)
+		}
+		return nil
+	})
+}
+
+func (i *Interactor) ResumeSchedule(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error) {
+	return i.updateSchedule(ctx, id, func(schedule *model.RecurringSchedule) error {
+		if err := schedule.Resume(i.clock.Now()); err != nil {
+			if errors.Is(err, model.ErrNotPaused) {
+				return errx.NewAppError("NOT_PAUSED", err.Error(), 409)
+			}
+			return invalidRecurrenceError(err)
+		}
+		return nil
+	})
+}
+
+func (i *Interactor) PreviewSchedule(ctx context.Context, input *PreviewScheduleInput) ([]time.Time, error) {
+	count := input.Count
+	if count <= 0 {
+		count = defaultPreviewCount
+	}
+	if count > maxPreviewCount {
+		count = maxPreviewCount
+	}
+
+	schedule, err := i.scheduleRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		log.Printf("Failed to find recurring schedule for preview: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	occurrences, err := schedule.Occurrences(i.clock.Now(), count)
+	if err != nil {
+		return nil, invalidRecurrenceError(err)
+	}
+
+	return occurrences, nil
+}
+
+// updateSchedule トランザクション内で取得・変更・保存を行う
+func (i *Interactor) updateSchedule(ctx context.Context, id uuid.UUID, change func(*model.RecurringSchedule) error) (*model.RecurringSchedule, error) {
+	var updated *model.RecurringSchedule
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		schedule, err := i.scheduleRepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("Failed to find recurring schedule for update: %v", err)
+			return errx.ErrNotFound
+		}
+
+		if err := change(schedule); err != nil {
+			return err
+		}
+
+		err = i.scheduleRepo.Update(ctx, schedule)
+		if err != nil {
+			log.Printf("Failed to update recurring schedule: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		updated = schedule
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// defaultTimezone ワークスペースの既定タイムゾーン（取得できない場合は組み込みの既定値）
+func (i *Interactor) defaultTimezone(ctx context.Context) string {
+	settings, err := i.settingsRepo.Get(ctx)
+	if err != nil {
+		log.Printf("Failed to get workspace settings: %v", err)
+		return model.DefaultWorkspaceTimezone
+	}
+	return settings.DefaultTimezone
+}
+
+// invalidRecurrenceError ドメインの繰り返しルール検証エラーをAppErrorに変換
+func invalidRecurrenceError(err error) error {
+	return errx.NewAppError("INVALID_RECURRENCE", err.Error(), 400)
+}
@@ -0,0 +1,51 @@
+package recurrence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+// CreateScheduleInput Expression は cron式（例: "0 20 * * FRI"）または RRULE（例: "FREQ=WEEKLY;BYDAY=FR;BYHOUR=20;BYMINUTE=0"）。
+// Timezone 未指定の場合はワークスペースの既定値
+type CreateScheduleInput struct {
+	MessageID  uuid.UUID  `json:"message_id"`
+	Expression string     `json:"expression"`
+	Timezone   string     `json:"timezone"`
+	StartsAt   *time.Time `json:"starts_at"`
+}
+
+type ListSchedulesInput struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+type PreviewScheduleInput struct {
+	ID    uuid.UUID `json:"-"`
+	Count int       `json:"count"`
+}
+
+type Usecase interface {
+	// CreateSchedule 繰り返し配信を作成
+	CreateSchedule(ctx context.Context, input *CreateScheduleInput) (*model.RecurringSchedule, error)
+
+	// ListSchedules 繰り返し配信一覧を取得
+	ListSchedules(ctx context.Context, input *ListSchedulesInput) ([]*model.RecurringSchedule, error)
+
+	// GetSchedule 繰り返し配信を取得
+	GetSchedule(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error)
+
+	// DeleteSchedule 繰り返し配信を削除（作成済みの配信には影響しない）
+	DeleteSchedule(ctx context.Context, id uuid.UUID) error
+
+	// PauseSchedule 一時停止
+	PauseSchedule(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error)
+
+	// ResumeSchedule 再開（停止中に過ぎた回は配信しない）
+	ResumeSchedule(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error)
+
+	// PreviewSchedule 今後の発生日時を最大 Count 件返す
+	PreviewSchedule(ctx context.Context, input *PreviewScheduleInput) ([]time.Time, error)
+}
@@ -0,0 +1,373 @@
+package richmenu
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/domain/service"
+	"vt-link/backend/internal/shared/clock"
+	"vt-link/backend/internal/shared/errx"
+)
+
+var (
+	errImageRequired   = errx.NewAppError("RICH_MENU_IMAGE_REQUIRED", "Upload the rich menu image first", 409)
+	errNotOnLine       = errx.NewAppError("RICH_MENU_NOT_ON_LINE", "The rich menu no longer exists on LINE; apply it again", 409)
+	errLineUnavailable = errx.NewAppError("RICH_MENU_UNAVAILABLE", "Failed to update the rich menu on LINE", 502)
+	errUserIDsRequired = errx.NewAppError("INVALID_INPUT", "user_ids is required", 400)
+)
+
+type Interactor struct {
+	richMenuRepo   repository.RichMenuRepository
+	richMenuClient service.RichMenuClient
+	clock          clock.Clock
+}
+
+func NewInteractor(
+	richMenuRepo repository.RichMenuRepository,
+	richMenuClient service.RichMenuClient,
+	clock clock.Clock,
+) Usecase {
+	return &Interactor{
+		richMenuRepo:   richMenuRepo,
+		richMenuClient: richMenuClient,
+		clock:          clock,
+	}
+}
+
+func (i *Interactor) CreateRichMenu(ctx context.Context, input *CreateRichMenuInput) (*model.RichMenu, error) {
+	menu, err := model.NewRichMenu(input.RichMenuDefinition)
+	if err != nil {
+		return nil, invalidError("INVALID_RICH_MENU", "Invalid rich menu", err)
+	}
+
+	lineRichMenuID, err := i.richMenuClient.CreateRichMenu(ctx, &menu.RichMenuDefinition)
+	if err != nil {
+		log.Printf("Failed to create rich menu on LINE: %v", err)
+		return nil, errLineUnavailable
+	}
+	menu.MarkApplied(lineRichMenuID, i.clock.Now())
+
+	if err := i.richMenuRepo.Create(ctx, menu); err != nil {
+		log.Printf("Failed to create rich menu: %v", err)
+		i.deleteLineRichMenu(ctx, lineRichMenuID)
+		return nil, errx.ErrInternalServer
+	}
+
+	return menu, nil
+}
+
+func (i *Interactor) ListRichMenus(ctx context.Context, input *ListRichMenusInput) ([]*model.RichMenu, error) {
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20 // デフォルト20件、最大100件
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	menus, err := i.richMenuRepo.List(ctx, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list rich menus: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return menus, nil
+}
+
+func (i *Interactor) GetRichMenu(ctx context.Context, id uuid.UUID) (*model.RichMenu, error) {
+	menu, err := i.richMenuRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find rich menu: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	return menu, nil
+}
+
+func (i *Interactor) UpdateRichMenu(ctx context.Context, input *UpdateRichMenuInput) (*model.RichMenu, error) {
+	menu, err := i.richMenuRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		log.Printf("Failed to find rich menu: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	if err := menu.SetDefinition(input.RichMenuDefinition); err != nil {
+		return nil, invalidError("INVALID_RICH_MENU", "Invalid rich menu", err)
+	}
+	if err := i.register(ctx, menu); err != nil {
+		return nil, err
+	}
+
+	return menu, nil
+}
+
+func (i *Interactor) UploadImage(ctx context.Context, input *UploadImageInput) (*model.RichMenu, error) {
+	menu, err := i.richMenuRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		log.Printf("Failed to find rich menu: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	// LINE上のリッチメニューの画像は差し替えられないため、画像がある場合（または未登録の場合）は作り直す
+	recreate := menu.HasImage() || menu.LineRichMenuID == ""
+	if err := menu.SetImage(input.ContentType, input.Image); err != nil {
+		return nil, invalidError("INVALID_IMAGE", "Invalid rich menu image", err)
+	}
+	if recreate {
+		if err := i.register(ctx, menu); err != nil {
+			return nil, err
+		}
+		return menu, nil
+	}
+
+	if err := i.richMenuClient.UploadRichMenuImage(ctx, menu.LineRichMenuID, menu.ImageContentType, menu.Image); err != nil {
+		log.Printf("Failed to upload rich menu image to LINE: %v", err)
+		return nil, lineError(err)
+	}
+	if err := i.richMenuRepo.Update(ctx, menu); err != nil {
+		log.Printf("Failed to update rich menu: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return menu, nil
+}
+
+func (i *Interactor) ApplyRichMenu(ctx context.Context, id uuid.UUID) (*model.RichMenu, error) {
+	menu, err := i.richMenuRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find rich menu: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	if err := i.register(ctx, menu); err != nil {
+		return nil, err
+	}
+
+	return menu, nil
+}
+
+func (i *Interactor) SetDefault(ctx context.Context, id uuid.UUID) (*model.RichMenu, error) {
+	menu, err := i.richMenuRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find rich menu: %v", err)
+		return nil, errx.ErrNotFound
+	}
+	if !menu.HasImage() {
+		return nil, errImageRequired
+	}
+
+	if err := i.richMenuClient.SetDefaultRichMenu(ctx, menu.LineRichMenuID); err != nil {
+		log.Printf("Failed to set default rich menu on LINE: %v", err)
+		return nil, lineError(err)
+	}
+	now := i.clock.Now()
+	if err := i.richMenuRepo.SetDefault(ctx, menu.ID, now); err != nil {
+		log.Printf("Failed to set default rich menu: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+	menu.IsDefault = true
+	menu.UpdatedAt = now
+
+	return menu, nil
+}
+
+func (i *Interactor) LinkUsers(ctx context.Context, input *LinkUsersInput) error {
+	userIDs, err := validateUserIDs(input.UserIDs)
+	if err != nil {
+		return err
+	}
+
+	menu, err := i.richMenuRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		log.Printf("Failed to find rich menu: %v", err)
+		return errx.ErrNotFound
+	}
+	if !menu.HasImage() {
+		return errImageRequired
+	}
+
+	// リンクできた分だけ記録し、失敗した場合は同じリクエストを再実行できるようにする
+	for _, chunk := range userIDs.Chunk(model.RichMenuLinkMaxUsers) {
+		if err := i.richMenuClient.LinkRichMenu(ctx, menu.LineRichMenuID, chunk); err != nil {
+			log.Printf("Failed to link rich menu %s on LINE: %v", menu.ID, err)
+			return lineError(err)
+		}
+		if err := i.richMenuRepo.LinkUsers(ctx, menu.ID, chunk, i.clock.Now()); err != nil {
+			log.Printf("Failed to record rich menu links: %v", err)
+			return errx.ErrInternalServer
+		}
+	}
+
+	return nil
+}
+
+func (i *Interactor) UnlinkUsers(ctx context.Context, input *UnlinkUsersInput) error {
+	userIDs, err := validateUserIDs(input.UserIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range userIDs.Chunk(model.RichMenuLinkMaxUsers) {
+		if err := i.richMenuClient.UnlinkRichMenu(ctx, chunk); err != nil {
+			log.Printf("Failed to unlink rich menu on LINE: %v", err)
+			return errLineUnavailable
+		}
+		if err := i.richMenuRepo.UnlinkUsers(ctx, chunk); err != nil {
+			log.Printf("Failed to record rich menu unlinks: %v", err)
+			return errx.ErrInternalServer
+		}
+	}
+
+	return nil
+}
+
+func (i *Interactor) DeleteRichMenu(ctx context.Context, id uuid.UUID) error {
+	menu, err := i.richMenuRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find rich menu: %v", err)
+		return errx.ErrNotFound
+	}
+
+	// LINE上で既に削除されている場合（チャネルのリセット後など）はDBからのみ削除する
+	if menu.LineRichMenuID != "" {
+		if err := i.richMenuClient.DeleteRichMenu(ctx, menu.LineRichMenuID); err != nil && !errors.Is(err, model.ErrLineRichMenuNotFound) {
+			log.Printf("Failed to delete rich menu on LINE: %v", err)
+			return errLineUnavailable
+		}
+	}
+
+	if err := i.richMenuRepo.Delete(ctx, id); err != nil {
+		log.Printf("Failed to delete rich menu: %v", err)
+		return errx.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (i *Interactor) ListRevisions(ctx context.Context, id uuid.UUID) ([]*model.RichMenuRevision, error) {
+	if _, err := i.richMenuRepo.FindByID(ctx, id); err != nil {
+		log.Printf("Failed to find rich menu for revisions: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	revisions, err := i.richMenuRepo.ListRevisions(ctx, id)
+	if err != nil {
+		log.Printf("Failed to list rich menu revisions: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return revisions, nil
+}
+
+// register LINE上にリッチメニューを作り直して保存する。画像・既定の設定・ユーザーへのリンクを引き継ぎ、
+// 以前のリッチメニューは削除する。途中で失敗した場合は作成したリッチメニューを削除し、DBは更新しない
+func (i *Interactor) register(ctx context.Context, menu *model.RichMenu) error {
+	previous := menu.LineRichMenuID
+
+	lineRichMenuID, err := i.richMenuClient.CreateRichMenu(ctx, &menu.RichMenuDefinition)
+	if err != nil {
+		log.Printf("Failed to create rich menu on LINE: %v", err)
+		return errLineUnavailable
+	}
+	if err := i.restore(ctx, menu, lineRichMenuID); err != nil {
+		i.deleteLineRichMenu(ctx, lineRichMenuID)
+		return err
+	}
+
+	menu.MarkApplied(lineRichMenuID, i.clock.Now())
+	if err := i.richMenuRepo.Update(ctx, menu); err != nil {
+		log.Printf("Failed to update rich menu: %v", err)
+		i.deleteLineRichMenu(ctx, lineRichMenuID)
+		return errx.ErrInternalServer
+	}
+
+	if previous != "" && previous != lineRichMenuID {
+		i.deleteLineRichMenu(ctx, previous)
+	}
+	return nil
+}
+
+// restore 作り直したリッチメニューに画像をアップロードし、既定の設定とユーザーへのリンクを再設定する
+// （画像がないリッチメニューは既定に設定・リンクできない）
+func (i *Interactor) restore(ctx context.Context, menu *model.RichMenu, lineRichMenuID string) error {
+	if !menu.HasImage() {
+		return nil
+	}
+	if err := i.richMenuClient.UploadRichMenuImage(ctx, lineRichMenuID, menu.ImageContentType, menu.Image); err != nil {
+		log.Printf("Failed to upload rich menu image to LINE: %v", err)
+		return errLineUnavailable
+	}
+	if menu.IsDefault {
+		if err := i.richMenuClient.SetDefaultRichMenu(ctx, lineRichMenuID); err != nil {
+			log.Printf("Failed to set default rich menu on LINE: %v", err)
+			return errLineUnavailable
+		}
+	}
+
+	userIDs, err := i.richMenuRepo.ListLinkedUserIDs(ctx, menu.ID)
+	if err != nil {
+		log.Printf("Failed to list rich menu links: %v", err)
+		return errx.ErrInternalServer
+	}
+	for _, chunk := range model.Recipients(userIDs).Chunk(model.RichMenuLinkMaxUsers) {
+		if err := i.richMenuClient.LinkRichMenu(ctx, lineRichMenuID, chunk); err != nil {
+			log.Printf("Failed to relink rich menu %s on LINE: %v", menu.ID, err)
+			return errLineUnavailable
+		}
+	}
+	return nil
+}
+
+// deleteLineRichMenu LINE上のリッチメニューを削除する（失敗しても処理は続けるため、ログのみ）
+func (i *Interactor) deleteLineRichMenu(ctx context.Context, lineRichMenuID string) {
+	if lineRichMenuID == "" {
+		return
+	}
+	if err := i.richMenuClient.DeleteRichMenu(ctx, lineRichMenuID); err != nil && !errors.Is(err, model.ErrLineRichMenuNotFound) {
+		log.Printf("Failed to delete rich menu %s on LINE: %v", lineRichMenuID, err)
+	}
+}
+
+// lineError LINE上にリッチメニューが存在しない場合は再登録を促すエラーに変換
+func lineError(err error) error {
+	if errors.Is(err, model.ErrLineRichMenuNotFound) {
+		return errNotOnLine
+	}
+	return errLineUnavailable
+}
+
+// validateUserIDs LINEユーザーIDの形式を検証し、重複を除く
+func validateUserIDs(userIDs []string) (model.Recipients, error) {
+	if len(userIDs) == 0 {
+		return nil, errUserIDsRequired
+	}
+	recipients := model.Recipients(userIDs)
+	if err := recipients.Validate(); err != nil {
+		return nil, invalidError("INVALID_USER_IDS", "Invalid LINE user IDs", err)
+	}
+	return recipients.Unique(), nil
+}
+
+// invalidError 検証エラーをフィールド単位のAppErrorに変換（送信先の検証エラーは user_ids のパスで返す）
+func invalidError(code, message string, err error) error {
+	fieldErrs := model.FieldErrors(err)
+	if fieldErrs == nil {
+		return errx.NewAppError(code, err.Error(), 400)
+	}
+	fields := make([]errx.FieldError, len(fieldErrs))
+	for idx, fieldErr := range fieldErrs {
+		field := fieldErr.Field
+		if strings.HasPrefix(field, "recipients[") {
+			field = "user_ids" + strings.TrimPrefix(field, "recipients")
+		}
+		fields[idx] = errx.FieldError{Field: field, Message: fieldErr.Message}
+	}
+	return errx.NewValidationError(code, message, fields)
+}
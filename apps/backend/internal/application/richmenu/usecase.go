@@ -0,0 +1,74 @@
+package richmenu
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type CreateRichMenuInput struct {
+	model.RichMenuDefinition
+}
+
+// UpdateRichMenuInput 定義全体を置き換える（LINE上のリッチメニューは作り直す）
+type UpdateRichMenuInput struct {
+	ID uuid.UUID `json:"-"`
+	model.RichMenuDefinition
+}
+
+type ListRichMenusInput struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// UploadImageInput Image は JPEG または PNG（最大 model.RichMenuImageMaxBytes バイト）
+type UploadImageInput struct {
+	ID          uuid.UUID
+	ContentType string
+	Image       []byte
+}
+
+type LinkUsersInput struct {
+	ID      uuid.UUID `json:"-"`
+	UserIDs []string  `json:"user_ids"`
+}
+
+type UnlinkUsersInput struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+type Usecase interface {
+	// CreateRichMenu リッチメニューを作成してLINEに登録
+	CreateRichMenu(ctx context.Context, input *CreateRichMenuInput) (*model.RichMenu, error)
+
+	// ListRichMenus リッチメニュー一覧を取得
+	ListRichMenus(ctx context.Context, input *ListRichMenusInput) ([]*model.RichMenu, error)
+
+	// GetRichMenu リッチメニューを取得
+	GetRichMenu(ctx context.Context, id uuid.UUID) (*model.RichMenu, error)
+
+	// UpdateRichMenu 定義を更新し（リビジョンを記録）、LINE上のリッチメニューを作り直す
+	UpdateRichMenu(ctx context.Context, input *UpdateRichMenuInput) (*model.RichMenu, error)
+
+	// UploadImage 画像を登録してLINEにアップロード（画像の差し替えはLINE上のリッチメニューを作り直す）
+	UploadImage(ctx context.Context, input *UploadImageInput) (*model.RichMenu, error)
+
+	// ApplyRichMenu DBの定義・画像・既定の設定・ユーザーへのリンクからLINE上のリッチメニューを作り直す（チャネルのリセット後など）
+	ApplyRichMenu(ctx context.Context, id uuid.UUID) (*model.RichMenu, error)
+
+	// SetDefault 既定のリッチメニューに設定
+	SetDefault(ctx context.Context, id uuid.UUID) (*model.RichMenu, error)
+
+	// LinkUsers ユーザーにリッチメニューをリンク（既定のリッチメニューより優先して表示される）
+	LinkUsers(ctx context.Context, input *LinkUsersInput) error
+
+	// UnlinkUsers ユーザーのリッチメニューのリンクを解除
+	UnlinkUsers(ctx context.Context, input *UnlinkUsersInput) error
+
+	// DeleteRichMenu リッチメニューをLINEとDBから削除
+	DeleteRichMenu(ctx context.Context, id uuid.UUID) error
+
+	// ListRevisions 定義のリビジョン一覧を取得（新しい順）
+	ListRevisions(ctx context.Context, id uuid.UUID) ([]*model.RichMenuRevision, error)
+}
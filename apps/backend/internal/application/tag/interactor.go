@@ -0,0 +1,132 @@
+package tag
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/shared/errx"
+)
+
+var errTagNameTaken = errx.NewAppError("TAG_NAME_TAKEN", "A tag with the same name already exists", 409)
+
+type Interactor struct {
+	tagRepo   repository.TagRepository
+	txManager repository.TxManager
+}
+
+func NewInteractor(
+	tagRepo repository.TagRepository,
+	txManager repository.TxManager,
+) Usecase {
+	return &Interactor{
+		tagRepo:   tagRepo,
+		txManager: txManager,
+	}
+}
+
+func (i *Interactor) CreateTag(ctx context.Context, input *CreateTagInput) (*model.Tag, error) {
+	tag, err := model.NewTag(input.Name, input.Color)
+	if err != nil {
+		return nil, errx.NewAppError("INVALID_TAG", err.Error(), 400)
+	}
+
+	err = i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := i.tagRepo.FindByName(ctx, tag.Name); err == nil {
+			return errTagNameTaken
+		}
+
+		if err := i.tagRepo.Create(ctx, tag); err != nil {
+			log.Printf("Failed to create tag: %v", err)
+			return errx.ErrInternalServer
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func (i *Interactor) ListTags(ctx context.Context, input *ListTagsInput) ([]*model.Tag, error) {
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100 // デフォルト100件、最大100件
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	tags, err := i.tagRepo.List(ctx, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list tags: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return tags, nil
+}
+
+func (i *Interactor) GetTag(ctx context.Context, id uuid.UUID) (*model.Tag, error) {
+	tag, err := i.tagRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to find tag: %v", err)
+		return nil, errx.ErrNotFound
+	}
+
+	return tag, nil
+}
+
+func (i *Interactor) UpdateTag(ctx context.Context, input *UpdateTagInput) (*model.Tag, error) {
+	var updated *model.Tag
+	err := i.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		tag, err := i.tagRepo.FindByID(ctx, input.ID)
+		if err != nil {
+			log.Printf("Failed to find tag for update: %v", err)
+			return errx.ErrNotFound
+		}
+
+		name, color := tag.Name, tag.Color
+		if input.Name != nil {
+			name = *input.Name
+		}
+		if input.Color != nil {
+			color = *input.Color
+		}
+		if err := tag.Edit(name, color); err != nil {
+			return errx.NewAppError("INVALID_TAG", err.Error(), 400)
+		}
+
+		// 大文字小文字だけの変更は同じタグとして許可する
+		if existing, err := i.tagRepo.FindByName(ctx, tag.Name); err == nil && existing.ID != tag.ID {
+			return errTagNameTaken
+		}
+
+		if err := i.tagRepo.Update(ctx, tag); err != nil {
+			log.Printf("Failed to update tag: %v", err)
+			return errx.ErrInternalServer
+		}
+
+		updated = tag
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (i *Interactor) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	err := i.tagRepo.Delete(ctx, id)
+	if err != nil {
+		log.Printf("Failed to delete tag: %v", err)
+		return errx.ErrNotFound
+	}
+
+	return nil
+}
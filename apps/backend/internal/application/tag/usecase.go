@@ -0,0 +1,43 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+// CreateTagInput Color は "#RRGGBB" 形式（未指定の場合は既定色）
+type CreateTagInput struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// UpdateTagInput 未指定（nil）のフィールドは現在の値を維持する
+type UpdateTagInput struct {
+	ID    uuid.UUID `json:"-"`
+	Name  *string   `json:"name"`
+	Color *string   `json:"color"`
+}
+
+type ListTagsInput struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+type Usecase interface {
+	// CreateTag タグを作成（同名のタグは作成不可）
+	CreateTag(ctx context.Context, input *CreateTagInput) (*model.Tag, error)
+
+	// ListTags タグ一覧を使用数付きで取得（名前順）
+	ListTags(ctx context.Context, input *ListTagsInput) ([]*model.Tag, error)
+
+	// GetTag タグを取得
+	GetTag(ctx context.Context, id uuid.UUID) (*model.Tag, error)
+
+	// UpdateTag タグの名前・色を変更
+	UpdateTag(ctx context.Context, input *UpdateTagInput) (*model.Tag, error)
+
+	// DeleteTag タグを削除（メッセージからも外れる）
+	DeleteTag(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/service"
+	"vt-link/backend/internal/shared/errx"
+)
+
+type Interactor struct {
+	parser   service.WebhookParser
+	handlers Handlers
+}
+
+func NewInteractor(parser service.WebhookParser, handlers Handlers) Usecase {
+	return &Interactor{
+		parser:   parser,
+		handlers: handlers,
+	}
+}
+
+func (i *Interactor) HandleWebhook(ctx context.Context, input *HandleWebhookInput) error {
+	payload, err := i.parser.Parse(input.Body, input.Signature)
+	if errors.Is(err, model.ErrWebhookNotConfigured) {
+		log.Println("LINE_CHANNEL_SECRET not configured, rejecting webhook")
+		return errx.NewAppError("WEBHOOK_NOT_CONFIGURED", "Webhook is not configured", 503)
+	}
+	if errors.Is(err, model.ErrInvalidSignature) {
+		return errx.NewAppError("INVALID_SIGNATURE", "Invalid signature", 401)
+	}
+	if err != nil {
+		log.Printf("Failed to parse webhook: %v", err)
+		return errx.ErrInvalidInput
+	}
+
+	// 1件の処理が失敗しても残りのイベントは処理する。
+	// エラーを返すとLINEはリクエスト全体を再送するため、成功した処理も重複してしまう
+	for _, event := range payload.Events {
+		for _, handler := range i.handlers[event.Type] {
+			if err := handler.HandleEvent(ctx, event); err != nil {
+				log.Printf("Failed to handle %s webhook event %s: %v", event.Type, event.WebhookEventID, err)
+			}
+		}
+	}
+
+	return nil
+}
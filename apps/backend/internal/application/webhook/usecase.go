@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"context"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type HandleWebhookInput struct {
+	// Body 署名の検証に使うため、受け取ったままのリクエストボディ
+	Body      []byte
+	Signature string
+}
+
+// EventHandler イベント種別ごとに登録する処理
+type EventHandler interface {
+	HandleEvent(ctx context.Context, event *model.WebhookEvent) error
+}
+
+// EventHandlerFunc 関数を EventHandler として登録するためのアダプタ
+type EventHandlerFunc func(ctx context.Context, event *model.WebhookEvent) error
+
+func (f EventHandlerFunc) HandleEvent(ctx context.Context, event *model.WebhookEvent) error {
+	return f(ctx, event)
+}
+
+// Handlers イベント種別ごとの処理（登録順に実行する）
+type Handlers map[model.WebhookEventType][]EventHandler
+
+type Usecase interface {
+	// HandleWebhook 署名を検証し、各イベントを登録済みの処理に振り分ける
+	HandleWebhook(ctx context.Context, input *HandleWebhookInput) error
+}
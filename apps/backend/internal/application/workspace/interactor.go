@@ -0,0 +1,52 @@
+package workspace
+
+import (
+	"context"
+	"log"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/shared/errx"
+)
+
+type Interactor struct {
+	settingsRepo repository.WorkspaceSettingsRepository
+}
+
+func NewInteractor(settingsRepo repository.WorkspaceSettingsRepository) Usecase {
+	return &Interactor{
+		settingsRepo: settingsRepo,
+	}
+}
+
+func (i *Interactor) GetSettings(ctx context.Context) (*model.WorkspaceSettings, error) {
+	settings, err := i.settingsRepo.Get(ctx)
+	if err != nil {
+		log.Printf("Failed to get workspace settings: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return settings, nil
+}
+
+func (i *Interactor) UpdateSettings(ctx context.Context, input *UpdateSettingsInput) (*model.WorkspaceSettings, error) {
+	settings, err := i.settingsRepo.Get(ctx)
+	if err != nil {
+		log.Printf("Failed to get workspace settings: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	if input.DefaultTimezone != nil {
+		if err := settings.SetDefaultTimezone(*input.DefaultTimezone); err != nil {
+			return nil, errx.NewAppError("INVALID_TIMEZONE", err.Error(), 400)
+		}
+	}
+
+	err = i.settingsRepo.Save(ctx, settings)
+	if err != nil {
+		log.Printf("Failed to save workspace settings: %v", err)
+		return nil, errx.ErrInternalServer
+	}
+
+	return settings, nil
+}
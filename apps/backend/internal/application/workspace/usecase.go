@@ -0,0 +1,19 @@
+package workspace
+
+import (
+	"context"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type UpdateSettingsInput struct {
+	DefaultTimezone *string `json:"default_timezone"`
+}
+
+type Usecase interface {
+	// GetSettings ワークスペース設定を取得
+	GetSettings(ctx context.Context) (*model.WorkspaceSettings, error)
+
+	// UpdateSettings ワークスペース設定を更新
+	UpdateSettings(ctx context.Context, input *UpdateSettingsInput) (*model.WorkspaceSettings, error)
+}
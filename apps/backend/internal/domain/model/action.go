@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+type ActionType string
+
+const (
+	ActionTypePostback       ActionType = "postback"
+	ActionTypeMessage        ActionType = "message"
+	ActionTypeURI            ActionType = "uri"
+	ActionTypeDatetimePicker ActionType = "datetimepicker"
+	// 以下はクイックリプライ専用
+	ActionTypeCamera     ActionType = "camera"
+	ActionTypeCameraRoll ActionType = "cameraRoll"
+	ActionTypeLocation   ActionType = "location"
+)
+
+// LINE アクションの制限値
+const (
+	ActionLabelMaxLength       = 20
+	ActionDataMaxLength        = 300
+	ActionDisplayTextMaxLength = 300
+	ActionTextMaxLength        = 300
+	ActionURIMaxLength         = 1000
+)
+
+var (
+	actionURISchemes    = []string{"http", "https", "line", "tel"}
+	datetimePickerModes = []string{"date", "time", "datetime"}
+)
+
+// Action ボタン等に設定するLINEアクション。Type に応じたフィールドのみ設定する
+type Action struct {
+	Type  ActionType `json:"type"`
+	Label string     `json:"label,omitempty"`
+	// Data postback / datetimepicker で送られるデータ
+	Data        string `json:"data,omitempty"`
+	DisplayText string `json:"display_text,omitempty"`
+	Text        string `json:"text,omitempty"`
+	URI         string `json:"uri,omitempty"`
+	// Mode datetimepicker の入力種別（date / time / datetime）
+	Mode    string `json:"mode,omitempty"`
+	Initial string `json:"initial,omitempty"`
+	Max     string `json:"max,omitempty"`
+	Min     string `json:"min,omitempty"`
+}
+
+// IsQuickReplyOnly クイックリプライでのみ使用できるアクションかどうか
+func (a *Action) IsQuickReplyOnly() bool {
+	return a.Type == ActionTypeCamera || a.Type == ActionTypeCameraRoll || a.Type == ActionTypeLocation
+}
+
+// Validate アクション種別ごとの必須項目と長さ制限を検証
+func (a *Action) Validate(path string, maxLabel int) error {
+	if a.Label == "" {
+		return invalidContent(fieldPath(path, "label"), "is required")
+	}
+	if UTF16Length(a.Label) > maxLabel {
+		return invalidContent(fieldPath(path, "label"), fmt.Sprintf("must be at most %d characters", maxLabel))
+	}
+	return a.validatePayload(path)
+}
+
+// validatePayload ラベル以外（種別固有）の項目を検証
+func (a *Action) validatePayload(path string) error {
+	switch a.Type {
+	case ActionTypePostback:
+		if a.Data == "" {
+			return invalidContent(fieldPath(path, "data"), "is required")
+		}
+		if UTF16Length(a.Data) > ActionDataMaxLength {
+			return invalidContent(fieldPath(path, "data"), fmt.Sprintf("must be at most %d characters", ActionDataMaxLength))
+		}
+		if UTF16Length(a.DisplayText) > ActionDisplayTextMaxLength {
+			return invalidContent(fieldPath(path, "display_text"), fmt.Sprintf("must be at most %d characters", ActionDisplayTextMaxLength))
+		}
+	case ActionTypeMessage:
+		if a.Text == "" {
+			return invalidContent(fieldPath(path, "text"), "is required")
+		}
+		if UTF16Length(a.Text) > ActionTextMaxLength {
+			return invalidContent(fieldPath(path, "text"), fmt.Sprintf("must be at most %d characters", ActionTextMaxLength))
+		}
+	case ActionTypeURI:
+		if err := validateActionURI(a.URI); err != nil {
+			return invalidContent(fieldPath(path, "uri"), err.Error())
+		}
+	case ActionTypeDatetimePicker:
+		if a.Data == "" {
+			return invalidContent(fieldPath(path, "data"), "is required")
+		}
+		if UTF16Length(a.Data) > ActionDataMaxLength {
+			return invalidContent(fieldPath(path, "data"), fmt.Sprintf("must be at most %d characters", ActionDataMaxLength))
+		}
+		if !slices.Contains(datetimePickerModes, a.Mode) {
+			return invalidContent(fieldPath(path, "mode"), "must be date, time or datetime")
+		}
+	case ActionTypeCamera, ActionTypeCameraRoll, ActionTypeLocation:
+		// ラベル以外の項目なし
+	default:
+		return invalidContent(fieldPath(path, "type"), fmt.Sprintf("%q is not supported", a.Type))
+	}
+	return nil
+}
+
+func validateActionURI(raw string) error {
+	if raw == "" {
+		return fmt.Errorf("is required")
+	}
+	if UTF16Length(raw) > ActionURIMaxLength {
+		return fmt.Errorf("must be at most %d characters", ActionURIMaxLength)
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("is not a valid URI")
+	}
+	if !slices.Contains(actionURISchemes, parsed.Scheme) {
+		return fmt.Errorf("scheme must be http, https, line or tel")
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// AutoReplyMatchType 受信テキストとキーワードの照合方法
+type AutoReplyMatchType string
+
+const (
+	// AutoReplyMatchExact キーワードのいずれかと完全一致（前後の空白と大文字小文字は無視）
+	AutoReplyMatchExact AutoReplyMatchType = "exact"
+	// AutoReplyMatchContains キーワードのいずれかを含む（大文字小文字は無視）
+	AutoReplyMatchContains AutoReplyMatchType = "contains"
+	// AutoReplyMatchRegex 正規表現（RE2）のいずれかに一致
+	AutoReplyMatchRegex AutoReplyMatchType = "regex"
+	// AutoReplyMatchFallback どのルールにも一致しなかった場合に応答する
+	AutoReplyMatchFallback AutoReplyMatchType = "fallback"
+)
+
+// IsValid 定義済みの照合方法かどうか
+func (t AutoReplyMatchType) IsValid() bool {
+	switch t {
+	case AutoReplyMatchExact, AutoReplyMatchContains, AutoReplyMatchRegex, AutoReplyMatchFallback:
+		return true
+	}
+	return false
+}
+
+// 自動応答ルールの制限値
+const (
+	AutoReplyNameMaxLength    = 100
+	AutoReplyMaxKeywords      = 20
+	AutoReplyKeywordMaxLength = 200
+)
+
+// AutoReplyKeywords 照合するキーワード・正規表現（JSONBで保存）
+type AutoReplyKeywords []string
+
+// Value JSONBとして保存
+func (k AutoReplyKeywords) Value() (driver.Value, error) {
+	if k == nil {
+		k = AutoReplyKeywords{}
+	}
+	return json.Marshal(k)
+}
+
+// Scan JSONBから読み込み
+func (k *AutoReplyKeywords) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, k)
+	case string:
+		return json.Unmarshal([]byte(v), k)
+	default:
+		return fmt.Errorf("unsupported type for AutoReplyKeywords: %T", src)
+	}
+}
+
+// AutoReplyWindow 応答する時間帯（"HH:MM" 形式、Start 以上 End 未満）。Start が End より後の場合は日付をまたぐ（例: 22:00〜06:00）
+type AutoReplyWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Validate 時刻の形式を検証
+func (w *AutoReplyWindow) Validate() error {
+	var errs []*FieldError
+	start, startErr := parseClock(w.Start)
+	if startErr != nil {
+		errs = append(errs, &FieldError{Field: "start", Message: startErr.Error()})
+	}
+	end, endErr := parseClock(w.End)
+	if endErr != nil {
+		errs = append(errs, &FieldError{Field: "end", Message: endErr.Error()})
+	}
+	if startErr == nil && endErr == nil && start == end {
+		errs = append(errs, &FieldError{Field: "end", Message: "must differ from start"})
+	}
+	return validationErrors(errs)
+}
+
+// Contains 時刻（ルールのタイムゾーンに変換済み）が時間帯に含まれるかどうか
+func (w *AutoReplyWindow) Contains(t time.Time) bool {
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return false
+	}
+	minute := t.Hour()*60 + t.Minute()
+	if start < end {
+		return start <= minute && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// parseClock "HH:MM" を0時からの分数に変換
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("must be formatted as HH:MM")
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Value JSONBとして保存
+func (w AutoReplyWindow) Value() (driver.Value, error) {
+	return json.Marshal(w)
+}
+
+// Scan JSONBから読み込み
+func (w *AutoReplyWindow) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, w)
+	case string:
+		return json.Unmarshal([]byte(v), w)
+	default:
+		return fmt.Errorf("unsupported type for AutoReplyWindow: %T", src)
+	}
+}
+
+// AutoReplyRule 受信したテキストメッセージに応答メッセージで返信するルール
+type AutoReplyRule struct {
+	ID        uuid.UUID          `json:"id" db:"id"`
+	Name      string             `json:"name" db:"name"`
+	MatchType AutoReplyMatchType `json:"match_type" db:"match_type"`
+	// Keywords 照合するキーワード（regex の場合は正規表現、fallback の場合は空）
+	Keywords AutoReplyKeywords `json:"keywords" db:"keywords"`
+	// Replies 応答メッセージ（最大5件を順番どおりに返信する）
+	Replies MessageParts `json:"replies" db:"replies"`
+	// Priority 大きいほど先に照合する（同じ場合は作成日時の古い順）
+	Priority int  `json:"priority" db:"priority"`
+	Enabled  bool `json:"enabled" db:"enabled"`
+	// Window 応答する時間帯（nil の場合は終日）。Timezone の壁時計で判定する
+	Window    *AutoReplyWindow `json:"window" db:"time_window"`
+	Timezone  string           `json:"timezone" db:"timezone"`
+	CreatedAt time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at" db:"updated_at"`
+
+	// patterns regex のキーワードをコンパイルしたもの（Validate または最初の照合時に作成し、以降の照合で使い回す）
+	patterns []*regexp.Regexp
+}
+
+// NewAutoReplyRule 新しい自動応答ルールを作成（有効な状態で作成する）
+func NewAutoReplyRule(name string, matchType AutoReplyMatchType, keywords AutoReplyKeywords, replies MessageParts, priority int, window *AutoReplyWindow, timezone string) (*AutoReplyRule, error) {
+	now := time.Now()
+	rule := &AutoReplyRule{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		MatchType: matchType,
+		Keywords:  keywords,
+		Replies:   replies,
+		Priority:  priority,
+		Enabled:   true,
+		Window:    window,
+		Timezone:  timezone,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Validate ルール全体を検証し、すべてのフィールドエラーを返す
+func (r *AutoReplyRule) Validate() error {
+	var errs []*FieldError
+
+	if r.Name == "" {
+		errs = append(errs, &FieldError{Field: "name", Message: "is required"})
+	} else if utf8.RuneCountInString(r.Name) > AutoReplyNameMaxLength {
+		errs = append(errs, &FieldError{Field: "name", Message: fmt.Sprintf("must be at most %d characters", AutoReplyNameMaxLength)})
+	}
+
+	switch {
+	case !r.MatchType.IsValid():
+		errs = append(errs, &FieldError{Field: "match_type", Message: "must be one of exact, contains, regex, fallback"})
+	case r.MatchType == AutoReplyMatchFallback && len(r.Keywords) > 0:
+		errs = append(errs, &FieldError{Field: "keywords", Message: "must be empty for fallback rules"})
+	case r.MatchType != AutoReplyMatchFallback && len(r.Keywords) == 0:
+		errs = append(errs, &FieldError{Field: "keywords", Message: "must contain at least 1 item"})
+	case len(r.Keywords) > AutoReplyMaxKeywords:
+		errs = append(errs, &FieldError{Field: "keywords", Message: fmt.Sprintf("must contain at most %d items", AutoReplyMaxKeywords)})
+	}
+	// キーワードが変更されている可能性があるため、検証のたびにコンパイルし直す
+	patterns := make([]*regexp.Regexp, 0, len(r.Keywords))
+	for i, keyword := range r.Keywords {
+		field := fmt.Sprintf("keywords[%d]", i)
+		switch {
+		case strings.TrimSpace(keyword) == "":
+			errs = append(errs, &FieldError{Field: field, Message: "must not be blank"})
+		case utf8.RuneCountInString(keyword) > AutoReplyKeywordMaxLength:
+			errs = append(errs, &FieldError{Field: field, Message: fmt.Sprintf("must be at most %d characters", AutoReplyKeywordMaxLength)})
+		case r.MatchType == AutoReplyMatchRegex:
+			pattern, err := regexp.Compile(keyword)
+			if err != nil {
+				errs = append(errs, &FieldError{Field: field, Message: "must be a valid regular expression: " + err.Error()})
+				continue
+			}
+			patterns = append(patterns, pattern)
+		}
+	}
+	r.patterns = patterns
+
+	if len(r.Replies) == 0 {
+		errs = append(errs, &FieldError{Field: "replies", Message: "must contain at least 1 item"})
+	}
+	errs = append(errs, FieldErrors(prefixField("replies", renameField("parts", r.Replies.Validate())))...)
+
+	if r.Window != nil {
+		errs = append(errs, FieldErrors(prefixField("window", r.Window.Validate()))...)
+	}
+	if _, err := LoadTimezone(r.Timezone); err != nil {
+		errs = append(errs, &FieldError{Field: "timezone", Message: err.Error()})
+	}
+
+	return validationErrors(errs)
+}
+
+// renameField MessageParts の検証エラーのパス（parts[i]...）から先頭の名前を除く
+func renameField(name string, err error) error {
+	fieldErrs := FieldErrors(err)
+	if fieldErrs == nil {
+		return err
+	}
+	renamed := make([]*FieldError, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		renamed[i] = &FieldError{Field: strings.TrimPrefix(strings.TrimPrefix(fieldErr.Field, name), "."), Message: fieldErr.Message}
+	}
+	return validationErrors(renamed)
+}
+
+// Matches 受信テキストがキーワードに一致するかどうか（fallback は常に false）
+func (r *AutoReplyRule) Matches(text string) bool {
+	if r.MatchType == AutoReplyMatchRegex {
+		for _, pattern := range r.regexPatterns() {
+			if pattern.MatchString(text) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, keyword := range r.Keywords {
+		switch r.MatchType {
+		case AutoReplyMatchExact:
+			if strings.EqualFold(strings.TrimSpace(text), strings.TrimSpace(keyword)) {
+				return true
+			}
+		case AutoReplyMatchContains:
+			if strings.Contains(strings.ToLower(text), strings.ToLower(keyword)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// regexPatterns コンパイル済みの正規表現（DBから読み込んだルールは最初の照合時にコンパイルする。不正なものは除く）
+func (r *AutoReplyRule) regexPatterns() []*regexp.Regexp {
+	if r.patterns == nil {
+		r.patterns = make([]*regexp.Regexp, 0, len(r.Keywords))
+		for _, keyword := range r.Keywords {
+			if pattern, err := regexp.Compile(keyword); err == nil {
+				r.patterns = append(r.patterns, pattern)
+			}
+		}
+	}
+	return r.patterns
+}
+
+// ActiveAt 日時が応答する時間帯に含まれるかどうか
+func (r *AutoReplyRule) ActiveAt(at time.Time) bool {
+	if r.Window == nil {
+		return true
+	}
+	loc, err := LoadTimezone(r.Timezone)
+	if err != nil {
+		return false
+	}
+	return r.Window.Contains(at.In(loc))
+}
+
+// MatchAutoReply 受信テキストに応答するルールを選ぶ。有効かつ時間帯内のルールを優先度順に照合し、
+// 一致するものがなければ fallback のルールを同じ順で選ぶ（応答しない場合は nil）
+func MatchAutoReply(rules []*AutoReplyRule, text string, at time.Time) *AutoReplyRule {
+	ordered := make([]*AutoReplyRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Enabled && rule.ActiveAt(at) {
+			ordered = append(ordered, rule)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority > ordered[j].Priority
+		}
+		return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+	})
+
+	for _, rule := range ordered {
+		if rule.MatchType != AutoReplyMatchFallback && rule.Matches(text) {
+			return rule
+		}
+	}
+	for _, rule := range ordered {
+		if rule.MatchType == AutoReplyMatchFallback {
+			return rule
+		}
+	}
+	return nil
+}
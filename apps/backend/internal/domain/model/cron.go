@@ -0,0 +1,141 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	cronMonthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	cronDayNames   = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// cronSchedule 標準的な5フィールドのcron式（分 時 日 月 曜日）
+type cronSchedule struct {
+	minutes  []int
+	hours    []int
+	days     []int
+	months   []int
+	weekdays []int
+	// 日・曜日の両方が指定された場合はどちらかに一致すれば発生する（Vixie cron と同じ）
+	dayRestricted     bool
+	weekdayRestricted bool
+	loc               *time.Location
+}
+
+func parseCron(expression string, loc *time.Location) (*cronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, invalidRecurrence(fmt.Sprintf("cron expression must have 5 fields, got %d", len(fields)))
+	}
+
+	c := &cronSchedule{loc: loc}
+	var err error
+	if c.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if c.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if c.days, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, err
+	}
+	if c.months, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, err
+	}
+	// 日曜日は 0 と 7 のどちらでも指定できる
+	if c.weekdays, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return nil, err
+	}
+	if slices.Contains(c.weekdays, 7) && !slices.Contains(c.weekdays, 0) {
+		c.weekdays = append([]int{0}, c.weekdays...)
+	}
+	c.dayRestricted = fields[2] != "*"
+	c.weekdayRestricted = fields[4] != "*"
+	return c, nil
+}
+
+func (c *cronSchedule) Next(after time.Time) time.Time {
+	after = after.In(c.loc)
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, c.loc)
+	for i := 0; i < recurrenceSearchDays; i++ {
+		if c.matchesDay(day) {
+			if times := dayTimes(day, c.hours, c.minutes, after); len(times) > 0 {
+				return times[0]
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+func (c *cronSchedule) matchesDay(day time.Time) bool {
+	if !slices.Contains(c.months, int(day.Month())) {
+		return false
+	}
+	dayMatch := slices.Contains(c.days, day.Day())
+	weekdayMatch := slices.Contains(c.weekdays, int(day.Weekday()))
+	if c.dayRestricted && c.weekdayRestricted {
+		return dayMatch || weekdayMatch
+	}
+	return dayMatch && weekdayMatch
+}
+
+// parseCronField "*", "1,15", "1-5", "*/15", "MON-FRI" 形式のフィールドを昇順の値一覧にする
+func parseCronField(field string, min, max int, names []string) ([]int, error) {
+	var values []int
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return nil, invalidRecurrence(fmt.Sprintf("invalid step in %q", field))
+			}
+			rangePart, step = part[:i], n
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseCronValue(bounds[0], min, max, names); err != nil {
+				return nil, err
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = parseCronValue(bounds[1], min, max, names); err != nil {
+					return nil, err
+				}
+			} else if step > 1 {
+				// "5/15" は 5 から最大値まで15刻み
+				hi = max
+			}
+			if lo > hi {
+				return nil, invalidRecurrence(fmt.Sprintf("invalid range in %q", field))
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			if !slices.Contains(values, v) {
+				values = append(values, v)
+			}
+		}
+	}
+	slices.Sort(values)
+	return values, nil
+}
+
+func parseCronValue(s string, min, max int, names []string) (int, error) {
+	if i := slices.Index(names, strings.ToUpper(s)); i >= 0 {
+		// 月名は1始まり、曜日名は0始まり
+		return i + min, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < min || v > max {
+		return 0, invalidRecurrence(fmt.Sprintf("value %q must be between %d and %d", s, min, max))
+	}
+	return v, nil
+}
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryBatchStatus 送信単位ごとの送信結果
+type DeliveryBatchStatus string
+
+const (
+	DeliveryBatchStatusPending DeliveryBatchStatus = "pending"
+	// DeliveryBatchStatusAccepted LINEが受け付け、送信が完了していない（絞り込み配信のみ）
+	DeliveryBatchStatusAccepted  DeliveryBatchStatus = "accepted"
+	DeliveryBatchStatusSucceeded DeliveryBatchStatus = "succeeded"
+	DeliveryBatchStatusFailed    DeliveryBatchStatus = "failed"
+)
+
+// DeliveryBatch 送信先を MulticastMaxRecipients 件ずつに分けた送信単位（ブロードキャスト・絞り込み配信は1件）。
+// 初回送信時に作成し、再送時は成功していないバッチだけを送る
+type DeliveryBatch struct {
+	ID         uuid.UUID           `json:"id" db:"id"`
+	MessageID  uuid.UUID           `json:"message_id" db:"message_id"`
+	Index      int                 `json:"index" db:"batch_index"`
+	Target     DeliveryTarget      `json:"target" db:"target"`
+	Recipients Recipients          `json:"recipients,omitempty" db:"recipients"`
+	Status     DeliveryBatchStatus `json:"status" db:"status"`
+	// Audience 送信数の集計に使う宛先数（ブロードキャストは送信時点で届く友だち数の見込み）
+	Audience int `json:"audience" db:"audience"`
+	// RequestID LINEが受け付けたリクエストのID（X-Line-Request-Id）
+	RequestID string     `json:"request_id,omitempty" db:"request_id"`
+	Error     string     `json:"error,omitempty" db:"error"`
+	Attempts  int        `json:"attempts" db:"attempts"`
+	SentAt    *time.Time `json:"sent_at,omitempty" db:"sent_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// NewDeliveryBatches 送信先をバッチに分割する
+func NewDeliveryBatches(messageID uuid.UUID, recipients Recipients, now time.Time) []*DeliveryBatch {
+	chunks := recipients.Chunk(MulticastMaxRecipients)
+	batches := make([]*DeliveryBatch, len(chunks))
+	for i, chunk := range chunks {
+		batches[i] = &DeliveryBatch{
+			ID:         uuid.New(),
+			MessageID:  messageID,
+			Index:      i,
+			Target:     DeliveryTargetRecipients,
+			Recipients: chunk,
+			Status:     DeliveryBatchStatusPending,
+			Audience:   len(chunk),
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+	}
+	return batches
+}
+
+// NewBroadcastBatch ブロードキャスト1回分の送信単位を作成
+func NewBroadcastBatch(messageID uuid.UUID, audience int, now time.Time) *DeliveryBatch {
+	return &DeliveryBatch{
+		ID:        uuid.New(),
+		MessageID: messageID,
+		Target:    DeliveryTargetBroadcast,
+		Status:    DeliveryBatchStatusPending,
+		Audience:  audience,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// NewNarrowcastBatch 絞り込み配信1回分の送信単位を作成（宛先数は送信完了時に確定する）
+func NewNarrowcastBatch(messageID uuid.UUID, now time.Time) *DeliveryBatch {
+	return &DeliveryBatch{
+		ID:        uuid.New(),
+		MessageID: messageID,
+		Target:    DeliveryTargetNarrowcast,
+		Status:    DeliveryBatchStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsSucceeded 送信済みかどうか（再送の対象外）
+func (b *DeliveryBatch) IsSucceeded() bool {
+	return b.Status == DeliveryBatchStatusSucceeded
+}
+
+// MarkAsSucceeded 送信成功を記録
+func (b *DeliveryBatch) MarkAsSucceeded(requestID string, now time.Time) {
+	b.Status = DeliveryBatchStatusSucceeded
+	b.RequestID = requestID
+	b.Error = ""
+	b.Attempts++
+	b.SentAt = &now
+	b.UpdatedAt = now
+}
+
+// MarkAsAccepted LINEが受け付けたことを記録（送信の完了は進捗の確認で反映する）
+func (b *DeliveryBatch) MarkAsAccepted(requestID string, now time.Time) {
+	b.Status = DeliveryBatchStatusAccepted
+	b.RequestID = requestID
+	b.Error = ""
+	b.Attempts++
+	b.UpdatedAt = now
+}
+
+// Complete 進捗の確認で送信完了を反映する（成功数を宛先数として集計する）
+func (b *DeliveryBatch) Complete(progress *NarrowcastProgress, now time.Time) {
+	b.Audience = progress.SuccessCount
+	b.UpdatedAt = now
+	if progress.Phase == NarrowcastPhaseSucceeded {
+		b.Status = DeliveryBatchStatusSucceeded
+		b.SentAt = &now
+		return
+	}
+	b.Status = DeliveryBatchStatusFailed
+	b.Error = progress.FailedDescription
+}
+
+// MarkAsFailed 送信失敗を記録
+func (b *DeliveryBatch) MarkAsFailed(reason string, now time.Time) {
+	b.Status = DeliveryBatchStatusFailed
+	b.Error = reason
+	b.Attempts++
+	b.UpdatedAt = now
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// DeliveryTarget メッセージの送信対象の種別
+type DeliveryTarget string
+
+const (
+	// DeliveryTargetRecipients 指定したユーザーにマルチキャストで送信（未指定の場合は既定の送信先へPush）
+	DeliveryTargetRecipients DeliveryTarget = "recipients"
+	// DeliveryTargetBroadcast 公式アカウントの友だち全員に送信
+	DeliveryTargetBroadcast DeliveryTarget = "broadcast"
+	// DeliveryTargetNarrowcast 属性情報・オーディエンスで絞り込んだ友だちに送信（送信完了はLINE側で非同期に進む）
+	DeliveryTargetNarrowcast DeliveryTarget = "narrowcast"
+)
+
+var deliveryTargets = []DeliveryTarget{DeliveryTargetRecipients, DeliveryTargetBroadcast, DeliveryTargetNarrowcast}
+
+// IsValid 定義済みの送信対象かどうか
+func (t DeliveryTarget) IsValid() bool {
+	return slices.Contains(deliveryTargets, t)
+}
+
+// ErrQuotaExceeded 今月の送信可能数を超える（errors.Is で判定可能）
+var ErrQuotaExceeded = errors.New("monthly message quota exceeded")
+
+// MessageQuota LINEの月間メッセージ送信数の上限と使用数
+type MessageQuota struct {
+	// Limited false の場合は上限なし
+	Limited bool `json:"limited"`
+	Limit   int  `json:"limit"`
+	Used    int  `json:"used"`
+}
+
+// Remaining 今月あと何通送れるか（上限なしの場合は -1）
+func (q *MessageQuota) Remaining() int {
+	if !q.Limited {
+		return -1
+	}
+	return max(0, q.Limit-q.Used)
+}
+
+// Reserve audience 通の送信が上限内に収まるか確認する
+func (q *MessageQuota) Reserve(audience int) error {
+	if q.Limited && q.Used+audience > q.Limit {
+		return fmt.Errorf("%w: %d messages needed, %d remaining", ErrQuotaExceeded, audience, q.Remaining())
+	}
+	return nil
+}
+
+// DeliveryUsage 今月この管理画面から送信した通数（送信対象別の内訳）。LINEの使用数には他経路での送信も含まれる
+type DeliveryUsage struct {
+	Quota    *MessageQuota          `json:"quota"`
+	ByTarget map[DeliveryTarget]int `json:"by_target"`
+}
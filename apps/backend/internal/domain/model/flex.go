@@ -0,0 +1,201 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// LINE Flex Message の制限値
+const (
+	FlexAltTextMaxLength    = 1500
+	FlexBubbleMaxBytes      = 30 * 1024
+	FlexCarouselMaxBytes    = 50 * 1024
+	FlexCarouselMaxBubbles  = 12
+	flexComponentMaxNesting = 10
+)
+
+// FlexContent Flex Message。Contents にはLINEのFlexコンテナ（bubble / carousel）のJSONをそのまま保持する
+type FlexContent struct {
+	AltText  string          `json:"alt_text"`
+	Contents json.RawMessage `json:"contents"`
+}
+
+// flexNode 検証用にFlex JSONを汎用的に読むための構造
+type flexNode map[string]json.RawMessage
+
+var (
+	flexBubbleSizes  = []string{"nano", "micro", "deca", "hecto", "kilo", "mega", "giga"}
+	flexBoxLayouts   = []string{"horizontal", "vertical", "baseline"}
+	flexHeroTypes    = []string{"box", "image", "video"}
+	flexComponentSet = []string{"box", "button", "image", "video", "icon", "text", "span", "separator", "filler"}
+)
+
+// Validate Flexコンテナの構造とサイズ制限を検証
+func (f *FlexContent) Validate() error {
+	if f.AltText == "" {
+		return invalidContent("alt_text", "is required")
+	}
+	if UTF16Length(f.AltText) > FlexAltTextMaxLength {
+		return invalidContent("alt_text", fmt.Sprintf("must be at most %d characters", FlexAltTextMaxLength))
+	}
+	if len(f.Contents) == 0 {
+		return invalidContent("contents", "is required")
+	}
+
+	container, err := parseFlexNode(f.Contents, "contents")
+	if err != nil {
+		return err
+	}
+
+	switch container.typ() {
+	case "bubble":
+		if len(f.Contents) > FlexBubbleMaxBytes {
+			return invalidContent("contents", fmt.Sprintf("bubble must be at most %d bytes", FlexBubbleMaxBytes))
+		}
+		return validateFlexBubble(container, "contents")
+	case "carousel":
+		if len(f.Contents) > FlexCarouselMaxBytes {
+			return invalidContent("contents", fmt.Sprintf("carousel must be at most %d bytes", FlexCarouselMaxBytes))
+		}
+		return validateFlexCarousel(container)
+	default:
+		return invalidContent("contents.type", fmt.Sprintf("must be bubble or carousel, got %q", container.typ()))
+	}
+}
+
+func validateFlexCarousel(carousel flexNode) error {
+	var bubbles []json.RawMessage
+	if err := json.Unmarshal(carousel["contents"], &bubbles); err != nil {
+		return invalidContent("contents.contents", "must be an array of bubbles")
+	}
+	if len(bubbles) == 0 || len(bubbles) > FlexCarouselMaxBubbles {
+		return invalidContent("contents.contents", fmt.Sprintf("must contain 1 to %d bubbles", FlexCarouselMaxBubbles))
+	}
+
+	for i, raw := range bubbles {
+		path := fmt.Sprintf("contents.contents[%d]", i)
+		if len(raw) > FlexBubbleMaxBytes {
+			return invalidContent(path, fmt.Sprintf("must be at most %d bytes", FlexBubbleMaxBytes))
+		}
+		bubble, err := parseFlexNode(raw, path)
+		if err != nil {
+			return err
+		}
+		if bubble.typ() != "bubble" {
+			return invalidContent(path+".type", "must be bubble")
+		}
+		if err := validateFlexBubble(bubble, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateFlexBubble(bubble flexNode, path string) error {
+	if size := bubble.str("size"); size != "" && !slices.Contains(flexBubbleSizes, size) {
+		return invalidContent(path+".size", fmt.Sprintf("%q is not supported", size))
+	}
+
+	blocks := 0
+	for _, name := range []string{"header", "hero", "body", "footer"} {
+		raw, ok := bubble[name]
+		if !ok {
+			continue
+		}
+		blocks++
+		blockPath := path + "." + name
+		block, err := parseFlexNode(raw, blockPath)
+		if err != nil {
+			return err
+		}
+		if name == "hero" {
+			if !slices.Contains(flexHeroTypes, block.typ()) {
+				return invalidContent(blockPath+".type", "must be box, image or video")
+			}
+		} else if block.typ() != "box" {
+			return invalidContent(blockPath+".type", "must be box")
+		}
+		if err := validateFlexComponent(block, blockPath, 0); err != nil {
+			return err
+		}
+	}
+	if blocks == 0 {
+		return invalidContent(path, "must have at least one of header, hero, body or footer")
+	}
+	return nil
+}
+
+func validateFlexComponent(component flexNode, path string, depth int) error {
+	if depth > flexComponentMaxNesting {
+		return invalidContent(path, "is nested too deeply")
+	}
+
+	typ := component.typ()
+	if !slices.Contains(flexComponentSet, typ) {
+		return invalidContent(path+".type", fmt.Sprintf("%q is not a flex component", typ))
+	}
+
+	switch typ {
+	case "box":
+		if !slices.Contains(flexBoxLayouts, component.str("layout")) {
+			return invalidContent(path+".layout", "must be horizontal, vertical or baseline")
+		}
+		var children []json.RawMessage
+		if err := json.Unmarshal(component["contents"], &children); err != nil {
+			return invalidContent(path+".contents", "must be an array")
+		}
+		for i, raw := range children {
+			childPath := fmt.Sprintf("%s.contents[%d]", path, i)
+			child, err := parseFlexNode(raw, childPath)
+			if err != nil {
+				return err
+			}
+			if err := validateFlexComponent(child, childPath, depth+1); err != nil {
+				return err
+			}
+		}
+	case "button":
+		if _, ok := component["action"]; !ok {
+			return invalidContent(path+".action", "is required")
+		}
+	case "image", "icon":
+		return validateMediaURL(path+".url", component.str("url"))
+	case "video":
+		if err := validateMediaURL(path+".url", component.str("url")); err != nil {
+			return err
+		}
+		return validateMediaURL(path+".previewUrl", component.str("previewUrl"))
+	case "text":
+		if component.str("text") == "" {
+			if _, ok := component["contents"]; !ok {
+				return invalidContent(path+".text", "is required")
+			}
+		}
+	case "span":
+		if component.str("text") == "" {
+			return invalidContent(path+".text", "is required")
+		}
+	}
+	return nil
+}
+
+func parseFlexNode(raw json.RawMessage, path string) (flexNode, error) {
+	var node flexNode
+	if err := json.Unmarshal(raw, &node); err != nil || node == nil {
+		return nil, invalidContent(path, "must be a JSON object")
+	}
+	return node, nil
+}
+
+func (n flexNode) str(key string) string {
+	var v string
+	if raw, ok := n[key]; ok {
+		_ = json.Unmarshal(raw, &v)
+	}
+	return v
+}
+
+func (n flexNode) typ() string {
+	return n.str("type")
+}
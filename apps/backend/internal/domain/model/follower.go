@@ -0,0 +1,111 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FollowerStatus 友だちの状態。LINEはブロックも友だち解除も unfollow イベントで通知するため区別しない
+type FollowerStatus string
+
+const (
+	FollowerStatusFollowing  FollowerStatus = "following"
+	FollowerStatusUnfollowed FollowerStatus = "unfollowed"
+)
+
+// IsValid 定義済みの状態かどうか
+func (s FollowerStatus) IsValid() bool {
+	return s == FollowerStatusFollowing || s == FollowerStatusUnfollowed
+}
+
+// LineProfile LINEから取得したユーザーのプロフィール
+type LineProfile struct {
+	UserID        string
+	DisplayName   string
+	PictureURL    string
+	StatusMessage string
+	// Language ユーザーの言語設定（取得に同意していない場合は空）
+	Language string
+}
+
+// Follower LINE公式アカウントの友だち。Webhookの友だち追加・ブロックのイベントで登録・更新する
+type Follower struct {
+	ID            uuid.UUID      `json:"id" db:"id"`
+	LineUserID    string         `json:"line_user_id" db:"line_user_id"`
+	DisplayName   string         `json:"display_name" db:"display_name"`
+	PictureURL    string         `json:"picture_url" db:"picture_url"`
+	StatusMessage string         `json:"status_message" db:"status_message"`
+	Language      string         `json:"language" db:"language"`
+	Status        FollowerStatus `json:"status" db:"status"`
+	// FollowedAt 最後に友だち追加（ブロック解除を含む）した日時
+	FollowedAt   *time.Time `json:"followed_at" db:"followed_at"`
+	UnfollowedAt *time.Time `json:"unfollowed_at" db:"unfollowed_at"`
+	// ProfileUpdatedAt 最後にプロフィールを取得した日時（未取得の場合は nil）
+	ProfileUpdatedAt *time.Time `json:"profile_updated_at" db:"profile_updated_at"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// NewFollower 未登録のLINEユーザーを友だちとして作成（状態はイベントの反映時に設定する）
+func NewFollower(lineUserID string) *Follower {
+	now := time.Now()
+	return &Follower{
+		ID:         uuid.New(),
+		LineUserID: lineUserID,
+		Status:     FollowerStatusFollowing,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Follow 友だち追加を反映。既に反映済みのイベントより古い場合（順序が入れ替わって届いた場合）は何もせず false を返す
+func (f *Follower) Follow(at time.Time) bool {
+	if f.isStale(at) {
+		return false
+	}
+	f.Status = FollowerStatusFollowing
+	f.FollowedAt = &at
+	f.UpdatedAt = time.Now()
+	return true
+}
+
+// Unfollow ブロック（友だち解除）を反映。古いイベントの扱いは Follow と同じ
+func (f *Follower) Unfollow(at time.Time) bool {
+	if f.isStale(at) {
+		return false
+	}
+	f.Status = FollowerStatusUnfollowed
+	f.UnfollowedAt = &at
+	f.UpdatedAt = time.Now()
+	return true
+}
+
+func (f *Follower) isStale(at time.Time) bool {
+	return (f.FollowedAt != nil && at.Before(*f.FollowedAt)) ||
+		(f.UnfollowedAt != nil && at.Before(*f.UnfollowedAt))
+}
+
+// SetProfile LINEのプロフィールを反映
+func (f *Follower) SetProfile(profile *LineProfile, now time.Time) {
+	f.DisplayName = profile.DisplayName
+	f.PictureURL = profile.PictureURL
+	f.StatusMessage = profile.StatusMessage
+	f.Language = profile.Language
+	f.ProfileUpdatedAt = &now
+	f.UpdatedAt = now
+}
+
+// FollowerFilter 一覧の絞り込み条件（未指定の項目は条件にしない）。
+// Query は表示名の部分一致、またはLINEユーザーIDの完全一致
+type FollowerFilter struct {
+	Status   FollowerStatus
+	Query    string
+	Language string
+}
+
+// FollowerPage 友だち一覧の1ページ分と絞り込み条件に一致する全件数
+type FollowerPage struct {
+	Followers []*Follower
+	Total     int
+}
@@ -1,59 +1,363 @@
 package model
 
 import (
-	"github.com/google/uuid"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
-)
 
-type MessageStatus string
-
-const (
-	MessageStatusDraft     MessageStatus = "draft"
-	MessageStatusScheduled MessageStatus = "scheduled"
-	MessageStatusSent      MessageStatus = "sent"
-	MessageStatusFailed    MessageStatus = "failed"
+	"github.com/google/uuid"
 )
 
 type Message struct {
-	ID          uuid.UUID     `json:"id" db:"id"`
-	Title       string        `json:"title" db:"title"`
-	Body        string        `json:"body" db:"message"`
-	Status      MessageStatus `json:"status" db:"status"`
-	ScheduledAt *time.Time    `json:"scheduled_at,omitempty" db:"scheduled_at"`
-	SentAt      *time.Time    `json:"sent_at,omitempty" db:"sent_at"`
-	CreatedAt   time.Time     `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time     `json:"updated_at" db:"updated_at"`
+	ID              uuid.UUID         `json:"id" db:"id"`
+	Title           string            `json:"title" db:"title"`
+	Body            string            `json:"body" db:"message"`
+	Content         *MessageContent   `json:"content,omitempty" db:"content"`
+	Parts           MessageParts      `json:"parts,omitempty" db:"parts"`
+	QuickReply      QuickReplyItems   `json:"quick_reply,omitempty" db:"quick_reply"`
+	Target          DeliveryTarget    `json:"target" db:"target"`
+	Recipients      Recipients        `json:"recipients,omitempty" db:"recipients"`
+	Narrowcast      *NarrowcastFilter `json:"narrowcast,omitempty" db:"narrowcast"`
+	DeliveryCounts  *DeliveryCounts   `json:"delivery_counts,omitempty" db:"delivery_counts"`
+	TemplateID      *uuid.UUID        `json:"template_id,omitempty" db:"template_id"`
+	Tags            []*Tag            `json:"tags,omitempty" db:"-"`
+	SourceMessageID *uuid.UUID        `json:"source_message_id,omitempty" db:"source_message_id"`
+	Status          MessageStatus     `json:"status" db:"status"`
+	ScheduledAt     *time.Time        `json:"scheduled_at,omitempty" db:"scheduled_at"`
+	// Timezone 予約時に指定された IANA タイムゾーン（未予約の場合は空）
+	Timezone string `json:"timezone,omitempty" db:"timezone"`
+	// ChangedBy 次に保存するリビジョンに記録する変更者（空の場合は記録しない。メッセージ自体には保存しない）
+	ChangedBy string     `json:"-" db:"-"`
+	SentAt    *time.Time `json:"sent_at,omitempty" db:"sent_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
 }
 
+var (
+	// ErrMessageDeleted ゴミ箱にあるメッセージは操作できない（errors.Is で判定可能）
+	ErrMessageDeleted = errors.New("message is in the trash")
+	// ErrMessageNotDeleted ゴミ箱にないメッセージは復元できない（errors.Is で判定可能）
+	ErrMessageNotDeleted = errors.New("message is not in the trash")
+	// ErrMessageSending 送信中のメッセージは削除できない（errors.Is で判定可能）
+	ErrMessageSending = errors.New("message is being sent")
+)
+
 // CanSend ビジネスルール：送信可能かどうか
 func (m *Message) CanSend() bool {
+	return !m.IsDeleted() && m.Status.CanTransitionTo(MessageStatusSending)
+}
+
+// CanEdit ビジネスルール：編集可能かどうか（送信済み・ゴミ箱にあるものは編集不可）
+func (m *Message) CanEdit() bool {
+	if m.IsDeleted() {
+		return false
+	}
 	return m.Status == MessageStatusDraft || m.Status == MessageStatusScheduled || m.Status == MessageStatusFailed
 }
 
+// IsDeleted ゴミ箱にあるかどうか
+func (m *Message) IsDeleted() bool {
+	return m.DeletedAt != nil
+}
+
+// transition 遷移表に従ってステータスを変更（ゴミ箱にある場合は不可）
+func (m *Message) transition(to MessageStatus) error {
+	if m.IsDeleted() {
+		return ErrMessageDeleted
+	}
+	if !m.Status.CanTransitionTo(to) {
+		return &TransitionError{From: m.Status, To: to}
+	}
+	m.Status = to
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// MarkAsSending 送信中にマーク
+func (m *Message) MarkAsSending() error {
+	return m.transition(MessageStatusSending)
+}
+
 // MarkAsSent 送信済みにマーク
-func (m *Message) MarkAsSent() {
+func (m *Message) MarkAsSent() error {
 	// 冪等性: 既に送信済みなら更新しない
-	if m.Status != MessageStatusSent || m.SentAt == nil {
-		now := time.Now()
-		m.SentAt = &now
+	if m.Status == MessageStatusSent && m.SentAt != nil {
+		return nil
 	}
-	m.Status = MessageStatusSent
-	m.UpdatedAt = time.Now()
+	if err := m.transition(MessageStatusSent); err != nil {
+		return err
+	}
+	now := time.Now()
+	m.SentAt = &now
+	return nil
+}
+
+// CompleteNarrowcast 絞り込み配信の送信完了を反映し、最終的な送信数を記録する
+func (m *Message) CompleteNarrowcast(progress *NarrowcastProgress) error {
+	if progress.Phase == NarrowcastPhaseSucceeded {
+		if err := m.MarkAsSent(); err != nil {
+			return err
+		}
+	} else if err := m.MarkAsFailed(); err != nil {
+		return err
+	}
+	m.DeliveryCounts = progress.Counts()
+	return nil
 }
 
 // MarkAsFailed 失敗にマーク
-func (m *Message) MarkAsFailed() {
-	m.Status = MessageStatusFailed
-	m.UpdatedAt = time.Now()
+func (m *Message) MarkAsFailed() error {
+	return m.transition(MessageStatusFailed)
 }
 
 // Schedule スケジュール設定
-func (m *Message) Schedule(scheduledAt time.Time) {
-	m.Status = MessageStatusScheduled
+func (m *Message) Schedule(scheduledAt time.Time) error {
+	if err := m.transition(MessageStatusScheduled); err != nil {
+		return err
+	}
 	m.ScheduledAt = &scheduledAt
+	return nil
+}
+
+// ScheduleIn タイムゾーン付きでスケジュール設定
+func (m *Message) ScheduleIn(scheduledAt time.Time, timezone string) error {
+	if _, err := LoadTimezone(timezone); err != nil {
+		return err
+	}
+	if err := m.Schedule(scheduledAt); err != nil {
+		return err
+	}
+	m.Timezone = timezone
+	return nil
+}
+
+// ScheduledAtLocal 予約日時を予約時のタイムゾーンのローカル時刻で返す（タイムゾーン未設定の場合は nil）
+func (m *Message) ScheduledAtLocal() *time.Time {
+	if m.ScheduledAt == nil || m.Timezone == "" {
+		return nil
+	}
+	loc, err := LoadTimezone(m.Timezone)
+	if err != nil {
+		return nil
+	}
+	local := m.ScheduledAt.In(loc)
+	return &local
+}
+
+// MarshalJSON 予約日時はUTCと、予約時タイムゾーンのローカル時刻の両方で返す
+func (m Message) MarshalJSON() ([]byte, error) {
+	type alias Message
+	view := struct {
+		alias
+		ScheduledAtLocal *time.Time `json:"scheduled_at_local,omitempty"`
+	}{alias: alias(m), ScheduledAtLocal: m.ScheduledAtLocal()}
+	if m.ScheduledAt != nil {
+		utc := m.ScheduledAt.UTC()
+		view.ScheduledAt = &utc
+	}
+	return json.Marshal(view)
+}
+
+// Unschedule スケジュールを解除して下書きに戻す
+func (m *Message) Unschedule() error {
+	if m.Status != MessageStatusScheduled {
+		return &TransitionError{From: m.Status, To: MessageStatusDraft}
+	}
+	if err := m.transition(MessageStatusDraft); err != nil {
+		return err
+	}
+	m.ScheduledAt = nil
+	m.Timezone = ""
+	return nil
+}
+
+// Cancel 配信を中止
+func (m *Message) Cancel() error {
+	if err := m.transition(MessageStatusCanceled); err != nil {
+		return err
+	}
+	m.ScheduledAt = nil
+	m.Timezone = ""
+	return nil
+}
+
+// Archive アーカイブ
+func (m *Message) Archive() error {
+	return m.transition(MessageStatusArchived)
+}
+
+// Delete ゴミ箱に移す。予約済みの場合も状態は維持し、ゴミ箱にある間はスケジューラの対象外になる
+func (m *Message) Delete(now time.Time) error {
+	if m.IsDeleted() {
+		return ErrMessageDeleted
+	}
+	if m.Status == MessageStatusSending {
+		return ErrMessageSending
+	}
+	m.DeletedAt = &now
+	m.UpdatedAt = now
+	return nil
+}
+
+// Restore ゴミ箱から戻す。ゴミ箱にある間に予約日時を過ぎた場合は下書きに戻す
+func (m *Message) Restore(now time.Time) error {
+	if !m.IsDeleted() {
+		return ErrMessageNotDeleted
+	}
+	m.DeletedAt = nil
+	m.UpdatedAt = now
+	if m.Status == MessageStatusScheduled && m.ScheduledAt != nil && !m.ScheduledAt.After(now) {
+		return m.Unschedule()
+	}
+	return nil
+}
+
+// Edit タイトル・本文を更新（配信予定日時の変更は ScheduleIn で行う）
+func (m *Message) Edit(title, body string) {
+	m.Title = title
+	m.Body = body
 	m.UpdatedAt = time.Now()
 }
 
+// SetContent リッチコンテンツを設定（nilの場合はタイトル・本文のテキストとして送信）。設定するとパートは解除される
+func (m *Message) SetContent(content *MessageContent) error {
+	if content != nil {
+		if err := content.Validate(); err != nil {
+			return err
+		}
+		m.Parts = nil
+	}
+	m.Content = content
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetParts 複数パートを設定（空の場合は解除）。設定すると単一の Content は解除される
+func (m *Message) SetParts(parts MessageParts) error {
+	if err := parts.Validate(); err != nil {
+		return err
+	}
+	if len(parts) > 0 {
+		m.Content = nil
+	}
+	m.Parts = parts
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// Contents 送信するメッセージ内容を順番に返す（空の場合はタイトル・本文のテキストとして送信）
+func (m *Message) Contents() MessageParts {
+	if len(m.Parts) > 0 {
+		return m.Parts
+	}
+	if m.Content != nil {
+		return MessageParts{*m.Content}
+	}
+	return nil
+}
+
+// SetQuickReply クイックリプライを設定（空の場合は解除）
+func (m *Message) SetQuickReply(items QuickReplyItems) error {
+	if err := items.Validate(); err != nil {
+		return err
+	}
+	m.QuickReply = items
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetTarget 送信対象の種別を設定（空の場合は送信先指定）。絞り込み配信以外にした場合は絞り込み条件を解除する
+func (m *Message) SetTarget(target DeliveryTarget) error {
+	if target == "" {
+		target = DeliveryTargetRecipients
+	}
+	if !target.IsValid() {
+		return invalidContent("target", fmt.Sprintf("%q is not a delivery target", target))
+	}
+	if target != DeliveryTargetNarrowcast {
+		m.Narrowcast = nil
+	}
+	m.Target = target
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetNarrowcast 絞り込み配信の条件を設定（nil の場合は解除）
+func (m *Message) SetNarrowcast(filter *NarrowcastFilter) error {
+	if filter != nil {
+		if err := prefixField("narrowcast", filter.Validate()); err != nil {
+			return err
+		}
+	}
+	m.Narrowcast = filter
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetRecipients 送信先のLINEユーザーIDを設定（重複は除く、空の場合は解除して既定の送信先に送る）
+func (m *Message) SetRecipients(recipients Recipients) error {
+	if err := recipients.Validate(); err != nil {
+		return err
+	}
+	m.Recipients = recipients.Unique()
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetTags タグを設定（重複は除く、空の場合は全て外す）
+func (m *Message) SetTags(tags []*Tag) error {
+	seen := map[uuid.UUID]bool{}
+	unique := make([]*Tag, 0, len(tags))
+	for _, tag := range tags {
+		if !seen[tag.ID] {
+			seen[tag.ID] = true
+			unique = append(unique, tag)
+		}
+	}
+	if len(unique) > MessageMaxTags {
+		return fmt.Errorf("%w: a message can have at most %d tags", ErrInvalidTag, MessageMaxTags)
+	}
+	m.Tags = unique
+	return nil
+}
+
+// TagIDs 設定されているタグのID
+func (m *Message) TagIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(m.Tags))
+	for i, tag := range m.Tags {
+		ids[i] = tag.ID
+	}
+	return ids
+}
+
+// Clone 内容・送信先・タグを複製した新しい下書きを作成（配信状態・予約日時は引き継がない）。複製元は SourceMessageID に記録する
+func (m *Message) Clone() *Message {
+	clone := NewMessage(m.Title, m.Body)
+	clone.SourceMessageID = &m.ID
+	clone.Content = m.Content
+	clone.Parts = m.Parts
+	clone.QuickReply = m.QuickReply
+	clone.Target = m.Target
+	clone.Recipients = m.Recipients
+	clone.Narrowcast = m.Narrowcast
+	clone.TemplateID = m.TemplateID
+	clone.Tags = m.Tags
+	return clone
+}
+
+// NewMessageFromTemplate テンプレートに変数を埋め込んでメッセージを作成
+func NewMessageFromTemplate(template *MessageTemplate, variables map[string]string) (*Message, error) {
+	title, body, err := template.Render(variables)
+	if err != nil {
+		return nil, err
+	}
+	message := NewMessage(title, body)
+	message.TemplateID = &template.ID
+	return message, nil
+}
+
 // NewMessage 新しいメッセージを作成
 func NewMessage(title, body string) *Message {
 	now := time.Now()
@@ -62,6 +366,7 @@ func NewMessage(title, body string) *Message {
 		Title:     title,
 		Body:      body,
 		Status:    MessageStatusDraft,
+		Target:    DeliveryTargetRecipients,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
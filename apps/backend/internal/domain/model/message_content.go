@@ -0,0 +1,230 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+type ContentType string
+
+const (
+	ContentTypeText     ContentType = "text"
+	ContentTypeImage    ContentType = "image"
+	ContentTypeVideo    ContentType = "video"
+	ContentTypeAudio    ContentType = "audio"
+	ContentTypeSticker  ContentType = "sticker"
+	ContentTypeLocation ContentType = "location"
+	ContentTypeFlex     ContentType = "flex"
+	ContentTypeTemplate ContentType = "template"
+)
+
+// ErrInvalidContent メッセージ内容が不正（errors.Is で判定可能）
+var ErrInvalidContent = errors.New("invalid message content")
+
+// LINE メッセージオブジェクトの制限値（文字数はUTF-16のコードユニット数）
+const (
+	TextMaxLength         = 5000
+	TextMaxEmojis         = 20
+	LocationTextMaxLength = 100
+	MediaURLMaxLength     = 2000
+)
+
+// MessageContent LINEに送信するメッセージ内容。Type に対応するフィールドのみ設定する
+type MessageContent struct {
+	Type     ContentType      `json:"type"`
+	Text     *TextContent     `json:"text,omitempty"`
+	Image    *ImageContent    `json:"image,omitempty"`
+	Video    *VideoContent    `json:"video,omitempty"`
+	Audio    *AudioContent    `json:"audio,omitempty"`
+	Sticker  *StickerContent  `json:"sticker,omitempty"`
+	Location *LocationContent `json:"location,omitempty"`
+	Flex     *FlexContent     `json:"flex,omitempty"`
+	Template *TemplateContent `json:"template,omitempty"`
+}
+
+type TextContent struct {
+	Text   string      `json:"text"`
+	Emojis []TextEmoji `json:"emojis,omitempty"`
+}
+
+// TextEmoji 本文中の "$" を置き換えるLINE絵文字
+type TextEmoji struct {
+	// Index 置き換える "$" の位置（UTF-16のコードユニット単位）
+	Index     int    `json:"index"`
+	ProductID string `json:"product_id"`
+	EmojiID   string `json:"emoji_id"`
+}
+
+type ImageContent struct {
+	OriginalContentURL string `json:"original_content_url"`
+	PreviewImageURL    string `json:"preview_image_url"`
+}
+
+type VideoContent struct {
+	OriginalContentURL string `json:"original_content_url"`
+	PreviewImageURL    string `json:"preview_image_url"`
+	TrackingID         string `json:"tracking_id,omitempty"`
+}
+
+type AudioContent struct {
+	OriginalContentURL string `json:"original_content_url"`
+	// Duration 再生時間（ミリ秒）
+	Duration int64 `json:"duration"`
+}
+
+type StickerContent struct {
+	PackageID string `json:"package_id"`
+	StickerID string `json:"sticker_id"`
+}
+
+type LocationContent struct {
+	Title     string  `json:"title"`
+	Address   string  `json:"address"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Validate 種別ごとの必須項目とLINEの制限値を検証
+func (c *MessageContent) Validate() error {
+	switch c.Type {
+	case ContentTypeText:
+		if c.Text == nil {
+			return invalidContent("text", "is required")
+		}
+		return prefixField("text", c.Text.Validate())
+	case ContentTypeImage:
+		if c.Image == nil {
+			return invalidContent("image", "is required")
+		}
+		return validationErrors(collectFieldErrors(
+			validateMediaURL("image.original_content_url", c.Image.OriginalContentURL),
+			validateMediaURL("image.preview_image_url", c.Image.PreviewImageURL),
+		))
+	case ContentTypeVideo:
+		if c.Video == nil {
+			return invalidContent("video", "is required")
+		}
+		return validationErrors(collectFieldErrors(
+			validateMediaURL("video.original_content_url", c.Video.OriginalContentURL),
+			validateMediaURL("video.preview_image_url", c.Video.PreviewImageURL),
+		))
+	case ContentTypeAudio:
+		if c.Audio == nil {
+			return invalidContent("audio", "is required")
+		}
+		var durationErr error
+		if c.Audio.Duration <= 0 {
+			durationErr = invalidContent("audio.duration", "must be positive")
+		}
+		return validationErrors(collectFieldErrors(
+			validateMediaURL("audio.original_content_url", c.Audio.OriginalContentURL),
+			durationErr,
+		))
+	case ContentTypeSticker:
+		if c.Sticker == nil || c.Sticker.PackageID == "" || c.Sticker.StickerID == "" {
+			return invalidContent("sticker", "package_id and sticker_id are required")
+		}
+	case ContentTypeLocation:
+		if c.Location == nil {
+			return invalidContent("location", "is required")
+		}
+		return prefixField("location", c.Location.Validate())
+	case ContentTypeFlex:
+		if c.Flex == nil {
+			return invalidContent("flex", "is required")
+		}
+		return prefixField("flex", c.Flex.Validate())
+	case ContentTypeTemplate:
+		if c.Template == nil {
+			return invalidContent("template", "is required")
+		}
+		return prefixField("template", c.Template.Validate())
+	default:
+		return invalidContent("type", fmt.Sprintf("%q is not supported", c.Type))
+	}
+	return nil
+}
+
+// Validate 本文の長さと絵文字の位置を検証
+func (t *TextContent) Validate() error {
+	if t.Text == "" {
+		return invalidContent("text", "is required")
+	}
+	if UTF16Length(t.Text) > TextMaxLength {
+		return invalidContent("text", fmt.Sprintf("must be at most %d characters", TextMaxLength))
+	}
+	if len(t.Emojis) > TextMaxEmojis {
+		return invalidContent("emojis", fmt.Sprintf("must contain at most %d items", TextMaxEmojis))
+	}
+
+	// 絵文字の index は本文中の "$" の位置を UTF-16 のコードユニット単位で指す
+	units := utf16.Encode([]rune(t.Text))
+	var errs []*FieldError
+	for i, emoji := range t.Emojis {
+		path := fmt.Sprintf("emojis[%d]", i)
+		if emoji.ProductID == "" || emoji.EmojiID == "" {
+			errs = append(errs, &FieldError{Field: path, Message: "product_id and emoji_id are required"})
+		}
+		if emoji.Index < 0 || emoji.Index >= len(units) || units[emoji.Index] != '$' {
+			errs = append(errs, &FieldError{Field: path + ".index", Message: "must point to a $ placeholder in the text"})
+		}
+	}
+	return validationErrors(errs)
+}
+
+// Validate タイトル・住所の長さと座標の範囲を検証
+func (l *LocationContent) Validate() error {
+	var errs []*FieldError
+	for _, field := range []struct{ name, value string }{{"title", l.Title}, {"address", l.Address}} {
+		if field.value == "" {
+			errs = append(errs, &FieldError{Field: field.name, Message: "is required"})
+		} else if UTF16Length(field.value) > LocationTextMaxLength {
+			errs = append(errs, &FieldError{Field: field.name, Message: fmt.Sprintf("must be at most %d characters", LocationTextMaxLength)})
+		}
+	}
+	if l.Latitude < -90 || l.Latitude > 90 || l.Longitude < -180 || l.Longitude > 180 {
+		errs = append(errs, &FieldError{Message: "coordinates are out of range"})
+	}
+	return validationErrors(errs)
+}
+
+// validateMediaURL LINEはHTTPSかつ一定長以下のURLのみ受け付ける
+func validateMediaURL(field, url string) error {
+	if !strings.HasPrefix(url, "https://") {
+		return invalidContent(field, "must use https")
+	}
+	if UTF16Length(url) > MediaURLMaxLength {
+		return invalidContent(field, fmt.Sprintf("must be at most %d characters", MediaURLMaxLength))
+	}
+	return nil
+}
+
+// collectFieldErrors nil でない検証エラーのフィールドエラーをまとめる
+func collectFieldErrors(errs ...error) []*FieldError {
+	var fieldErrs []*FieldError
+	for _, err := range errs {
+		fieldErrs = append(fieldErrs, FieldErrors(err)...)
+	}
+	return fieldErrs
+}
+
+// Value JSONBとして保存
+func (c MessageContent) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan JSONBから読み込み
+func (c *MessageContent) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("unsupported type for MessageContent: %T", src)
+	}
+}
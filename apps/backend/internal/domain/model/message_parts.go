@@ -0,0 +1,48 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MessageMaxParts LINEの1回のPushで送信できるメッセージオブジェクトは最大5件
+const MessageMaxParts = 5
+
+// MessageParts 1回のPushで順番どおりに送信するメッセージ内容（JSONBで保存）
+type MessageParts []MessageContent
+
+// Validate 件数と各パートの内容を検証し、すべてのフィールドエラーを返す
+func (parts MessageParts) Validate() error {
+	if len(parts) > MessageMaxParts {
+		return invalidContent("parts", fmt.Sprintf("must contain at most %d items", MessageMaxParts))
+	}
+	var errs []*FieldError
+	for i := range parts {
+		errs = append(errs, FieldErrors(prefixField(fmt.Sprintf("parts[%d]", i), parts[i].Validate()))...)
+	}
+	return validationErrors(errs)
+}
+
+// Value JSONBとして保存（空の場合はNULL）
+func (parts MessageParts) Value() (driver.Value, error) {
+	if len(parts) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(parts)
+}
+
+// Scan JSONBから読み込み
+func (parts *MessageParts) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*parts = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, parts)
+	case string:
+		return json.Unmarshal([]byte(v), parts)
+	default:
+		return fmt.Errorf("unsupported type for MessageParts: %T", src)
+	}
+}
@@ -0,0 +1,194 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MessageSortField 一覧の並び替えに使える項目
+type MessageSortField string
+
+const (
+	MessageSortCreatedAt   MessageSortField = "created_at"
+	MessageSortUpdatedAt   MessageSortField = "updated_at"
+	MessageSortScheduledAt MessageSortField = "scheduled_at"
+	MessageSortSentAt      MessageSortField = "sent_at"
+	MessageSortTitle       MessageSortField = "title"
+	MessageSortDeletedAt   MessageSortField = "deleted_at"
+)
+
+var messageSortFields = []MessageSortField{
+	MessageSortCreatedAt,
+	MessageSortUpdatedAt,
+	MessageSortScheduledAt,
+	MessageSortSentAt,
+	MessageSortTitle,
+	MessageSortDeletedAt,
+}
+
+var (
+	// ErrInvalidSort 並び替え指定が不正（errors.Is で判定可能）
+	ErrInvalidSort = errors.New("invalid sort")
+	// ErrInvalidCursor カーソルが不正、または並び替え指定と一致しない（errors.Is で判定可能）
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// MessageSort 並び替え指定。値が NULL の行（未予約・未送信など）は昇順・降順とも末尾に並ぶ
+type MessageSort struct {
+	Field MessageSortField
+	Desc  bool
+}
+
+// DefaultMessageSort 作成日時の新しい順
+var DefaultMessageSort = MessageSort{Field: MessageSortCreatedAt, Desc: true}
+
+// DefaultTrashSort ゴミ箱の一覧は削除日時の新しい順
+var DefaultTrashSort = MessageSort{Field: MessageSortDeletedAt, Desc: true}
+
+// ParseMessageSort "scheduled_at"（昇順）や "-scheduled_at"（降順）の形式を解釈する。空の場合は既定の並び
+func ParseMessageSort(value string) (MessageSort, error) {
+	if value == "" {
+		return DefaultMessageSort, nil
+	}
+	sort := MessageSort{Field: MessageSortField(strings.TrimPrefix(value, "-")), Desc: strings.HasPrefix(value, "-")}
+	if !slices.Contains(messageSortFields, sort.Field) {
+		return MessageSort{}, fmt.Errorf("%w: %q is not a sortable field", ErrInvalidSort, sort.Field)
+	}
+	return sort, nil
+}
+
+func (s MessageSort) String() string {
+	if s.Desc {
+		return "-" + string(s.Field)
+	}
+	return string(s.Field)
+}
+
+// MessageFilter 一覧の絞り込み条件（未指定の項目は条件にしない）。
+// 日時の範囲は From 以上 To 未満、タグはいずれかが付いていれば一致、SourceMessageID は指定したメッセージからの複製に絞り込む。
+// Deleted が false の場合はゴミ箱にないもの、true の場合はゴミ箱にあるものだけを対象にする
+type MessageFilter struct {
+	Deleted         bool
+	Statuses        []MessageStatus
+	TagIDs          []uuid.UUID
+	SourceMessageID *uuid.UUID
+	ScheduledFrom   *time.Time
+	ScheduledTo     *time.Time
+	SentFrom        *time.Time
+	SentTo          *time.Time
+}
+
+// Validate ステータスと日時範囲を検証
+func (f *MessageFilter) Validate() error {
+	for _, status := range f.Statuses {
+		if !status.IsValid() {
+			return fmt.Errorf("unknown status %q", status)
+		}
+	}
+	if f.ScheduledFrom != nil && f.ScheduledTo != nil && !f.ScheduledFrom.Before(*f.ScheduledTo) {
+		return fmt.Errorf("scheduled_from must be before scheduled_to")
+	}
+	if f.SentFrom != nil && f.SentTo != nil && !f.SentFrom.Before(*f.SentTo) {
+		return fmt.Errorf("sent_from must be before sent_to")
+	}
+	return nil
+}
+
+// MessageQuery 一覧取得の条件。Cursor 指定時は Offset を使わずにその続きから取得する
+type MessageQuery struct {
+	Filter MessageFilter
+	Sort   MessageSort
+	Cursor *MessageCursor
+	Limit  int
+	Offset int
+}
+
+// MessageCursor キーセットページング用のカーソル。直前のページの最後の行の並び替えキーとIDを保持する
+type MessageCursor struct {
+	Sort string `json:"s"`
+	// Value 並び替えキーの値（日時はRFC3339Nano、NULLの場合は nil）
+	Value *string   `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// NewMessageCursor メッセージの並び替えキーからカーソルを作成
+func NewMessageCursor(message *Message, sort MessageSort) *MessageCursor {
+	cursor := &MessageCursor{Sort: sort.String(), ID: message.ID}
+	formatTime := func(t *time.Time) *string {
+		if t == nil {
+			return nil
+		}
+		value := t.UTC().Format(time.RFC3339Nano)
+		return &value
+	}
+
+	switch sort.Field {
+	case MessageSortCreatedAt:
+		cursor.Value = formatTime(&message.CreatedAt)
+	case MessageSortUpdatedAt:
+		cursor.Value = formatTime(&message.UpdatedAt)
+	case MessageSortScheduledAt:
+		cursor.Value = formatTime(message.ScheduledAt)
+	case MessageSortSentAt:
+		cursor.Value = formatTime(message.SentAt)
+	case MessageSortTitle:
+		cursor.Value = &message.Title
+	case MessageSortDeletedAt:
+		cursor.Value = formatTime(message.DeletedAt)
+	}
+	return cursor
+}
+
+// Encode URLにそのまま使える文字列に変換
+func (c *MessageCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// KeyValue 並び替えキーの値をSQLのパラメータとして使える型で返す（NULLの場合は nil）
+func (c *MessageCursor) KeyValue(field MessageSortField) (interface{}, error) {
+	if c.Value == nil {
+		return nil, nil
+	}
+	if field == MessageSortTitle {
+		return *c.Value, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, *c.Value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return t, nil
+}
+
+// DecodeMessageCursor カーソル文字列を解釈する。別の並び替えで発行されたカーソルは使えない
+func DecodeMessageCursor(value string, sort MessageSort) (*MessageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor MessageCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort != sort.String() {
+		return nil, fmt.Errorf("%w: issued for sort %q", ErrInvalidCursor, cursor.Sort)
+	}
+	if _, err := cursor.KeyValue(sort.Field); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+// MessagePage 一覧の1ページ分。Total は絞り込み条件に一致する全件数、NextCursor は続きがない場合は空
+type MessagePage struct {
+	Messages   []*Message
+	Total      int
+	NextCursor string
+}
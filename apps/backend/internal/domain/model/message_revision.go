@@ -0,0 +1,86 @@
+package model
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MessageRevision メッセージの保存時点のスナップショット。作成・更新のたびに連番で記録する
+type MessageRevision struct {
+	ID          uuid.UUID       `json:"id" db:"id"`
+	MessageID   uuid.UUID       `json:"message_id" db:"message_id"`
+	Revision    int             `json:"revision" db:"revision"`
+	Title       string          `json:"title" db:"title"`
+	Body        string          `json:"body" db:"message"`
+	Content     *MessageContent `json:"content,omitempty" db:"content"`
+	Parts       MessageParts    `json:"parts,omitempty" db:"parts"`
+	QuickReply  QuickReplyItems `json:"quick_reply,omitempty" db:"quick_reply"`
+	Status      MessageStatus   `json:"status" db:"status"`
+	ScheduledAt *time.Time      `json:"scheduled_at,omitempty" db:"scheduled_at"`
+	Timezone    string          `json:"timezone,omitempty" db:"timezone"`
+	// ChangedBy 変更者（API からの変更で X-Actor ヘッダーが指定された場合のみ）
+	ChangedBy *string   `json:"changed_by,omitempty" db:"changed_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	// ChangedFields 直前のリビジョンから変更された項目（保存はせず一覧取得時に算出）
+	ChangedFields []string `json:"changed_fields" db:"-"`
+}
+
+// DiffFrom 直前のリビジョンとの差分項目名を返す。prev が nil（初版）の場合は空
+func (r *MessageRevision) DiffFrom(prev *MessageRevision) []string {
+	changed := []string{}
+	if prev == nil {
+		return changed
+	}
+	if r.Title != prev.Title {
+		changed = append(changed, "title")
+	}
+	if r.Body != prev.Body {
+		changed = append(changed, "body")
+	}
+	if !reflect.DeepEqual(r.Content, prev.Content) {
+		changed = append(changed, "content")
+	}
+	if !reflect.DeepEqual(r.Parts, prev.Parts) {
+		changed = append(changed, "parts")
+	}
+	if !reflect.DeepEqual(r.QuickReply, prev.QuickReply) {
+		changed = append(changed, "quick_reply")
+	}
+	if r.Status != prev.Status {
+		changed = append(changed, "status")
+	}
+	if !equalTimePtr(r.ScheduledAt, prev.ScheduledAt) || r.Timezone != prev.Timezone {
+		changed = append(changed, "scheduled_at")
+	}
+	return changed
+}
+
+// RestoreRevision リビジョンの内容を下書きとして復元する（予約は解除される。ゴミ箱にある場合は不可）
+func (m *Message) RestoreRevision(revision *MessageRevision) error {
+	if m.IsDeleted() {
+		return ErrMessageDeleted
+	}
+	if m.Status != MessageStatusDraft {
+		if err := m.transition(MessageStatusDraft); err != nil {
+			return err
+		}
+	}
+	m.Title = revision.Title
+	m.Body = revision.Body
+	m.Content = revision.Content
+	m.Parts = revision.Parts
+	m.QuickReply = revision.QuickReply
+	m.ScheduledAt = nil
+	m.Timezone = ""
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
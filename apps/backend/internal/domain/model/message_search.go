@@ -0,0 +1,130 @@
+package model
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// 検索の制限値
+const (
+	SearchMaxTerms      = 10
+	SearchSnippetLength = 80
+)
+
+// MessageSearchResult 検索にヒットしたメッセージ。ハイライトは HTML エスケープ済みで、一致箇所を <mark> で囲む
+type MessageSearchResult struct {
+	Message *Message `json:"message"`
+	// Rank 関連度（大きいほど上位。タイトルでの一致を本文より重視する）
+	Rank           float64 `json:"rank"`
+	TitleHighlight string  `json:"title_highlight"`
+	Snippet        string  `json:"snippet"`
+}
+
+// Highlight 検索語でタイトルと本文の抜粋をハイライトする
+func (r *MessageSearchResult) Highlight(terms []string) {
+	r.TitleHighlight = highlight([]rune(r.Message.Title), terms, 0, len([]rune(r.Message.Title)))
+
+	body := []rune(r.Message.Body)
+	start, end := snippetWindow(body, terms, SearchSnippetLength)
+	snippet := highlight(body, terms, start, end)
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(body) {
+		snippet += "…"
+	}
+	r.Snippet = snippet
+}
+
+// SearchTerms 検索クエリを空白（全角スペースを含む）で区切った検索語に分割する（重複は除く）
+func SearchTerms(query string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, term := range strings.FieldsFunc(query, unicode.IsSpace) {
+		key := strings.ToLower(term)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		terms = append(terms, term)
+		if len(terms) == SearchMaxTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// snippetWindow 最初に一致した検索語の周辺を length 文字分切り出す範囲を返す
+func snippetWindow(text []rune, terms []string, length int) (int, int) {
+	if len(text) <= length {
+		return 0, len(text)
+	}
+	first := len(text)
+	for _, match := range findMatches(text, terms, 0, len(text)) {
+		first = min(first, match[0])
+	}
+	if first == len(text) {
+		return 0, length
+	}
+	// 一致箇所の前に少し文脈を残す
+	start := max(0, first-length/4)
+	end := min(len(text), start+length)
+	return max(0, end-length), end
+}
+
+// highlight text[start:end] をエスケープし、検索語との一致箇所を <mark> で囲む
+func highlight(text []rune, terms []string, start, end int) string {
+	var b strings.Builder
+	pos := start
+	for _, match := range findMatches(text, terms, start, end) {
+		b.WriteString(html.EscapeString(string(text[pos:match[0]])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(text[match[0]:match[1]])))
+		b.WriteString("</mark>")
+		pos = match[1]
+	}
+	b.WriteString(html.EscapeString(string(text[pos:end])))
+	return b.String()
+}
+
+// findMatches text[start:end] 内の検索語の一致範囲（大文字小文字を区別しない）を重ならないよう先頭から返す
+func findMatches(text []rune, terms []string, start, end int) [][2]int {
+	folded := make([]rune, len(text))
+	for i, r := range text {
+		folded[i] = unicode.ToLower(r)
+	}
+	needles := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		if len(needle) > 0 {
+			needles = append(needles, needle)
+		}
+	}
+
+	var matches [][2]int
+	for i := start; i < end; {
+		longest := 0
+		for _, needle := range needles {
+			if len(needle) > longest && i+len(needle) <= end && runesEqual(folded[i:i+len(needle)], needle) {
+				longest = len(needle)
+			}
+		}
+		if longest == 0 {
+			i++
+			continue
+		}
+		matches = append(matches, [2]int{i, i + longest})
+		i += longest
+	}
+	return matches
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
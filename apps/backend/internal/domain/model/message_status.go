@@ -0,0 +1,62 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+type MessageStatus string
+
+const (
+	MessageStatusDraft     MessageStatus = "draft"
+	MessageStatusScheduled MessageStatus = "scheduled"
+	MessageStatusSending   MessageStatus = "sending"
+	MessageStatusSent      MessageStatus = "sent"
+	MessageStatusFailed    MessageStatus = "failed"
+	MessageStatusCanceled  MessageStatus = "canceled"
+	MessageStatusArchived  MessageStatus = "archived"
+)
+
+// ErrInvalidTransition 不正なステータス遷移（errors.Is で判定可能）
+var ErrInvalidTransition = errors.New("invalid message status transition")
+
+// TransitionError 遷移元・遷移先を保持するステータス遷移エラー
+type TransitionError struct {
+	From MessageStatus
+	To   MessageStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot transition message from %s to %s", e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+// messageTransitions 許可されたステータス遷移の一覧（遷移元 → 遷移先）
+var messageTransitions = map[MessageStatus][]MessageStatus{
+	MessageStatusDraft:     {MessageStatusScheduled, MessageStatusSending, MessageStatusCanceled, MessageStatusArchived},
+	MessageStatusScheduled: {MessageStatusDraft, MessageStatusScheduled, MessageStatusSending, MessageStatusCanceled},
+	MessageStatusSending:   {MessageStatusSent, MessageStatusFailed},
+	MessageStatusSent:      {MessageStatusArchived},
+	MessageStatusFailed:    {MessageStatusDraft, MessageStatusScheduled, MessageStatusSending, MessageStatusCanceled, MessageStatusArchived},
+	MessageStatusCanceled:  {MessageStatusDraft, MessageStatusArchived},
+	MessageStatusArchived:  {},
+}
+
+// IsValid 定義済みのステータスかどうか
+func (s MessageStatus) IsValid() bool {
+	_, ok := messageTransitions[s]
+	return ok
+}
+
+// CanTransitionTo 指定ステータスへ遷移可能かどうか
+func (s MessageStatus) CanTransitionTo(to MessageStatus) bool {
+	for _, allowed := range messageTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrMissingTemplateVariable テンプレートの変数が不足している（errors.Is で判定可能）
+var ErrMissingTemplateVariable = errors.New("missing template variable")
+
+// MissingVariablesError 不足している変数名を保持するエラー
+type MissingVariablesError struct {
+	Names []string
+}
+
+func (e *MissingVariablesError) Error() string {
+	return fmt.Sprintf("missing template variables: %s", strings.Join(e.Names, ", "))
+}
+
+func (e *MissingVariablesError) Is(target error) bool {
+	return target == ErrMissingTemplateVariable
+}
+
+// templatePlaceholder {{variable}} 形式のプレースホルダ（前後の空白は許容）
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// MessageTemplate 繰り返し配信するメッセージの雛形
+type MessageTemplate struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Title     string    `json:"title" db:"title"`
+	Body      string    `json:"body" db:"body"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Variables タイトル・本文に含まれる変数名（重複なし、出現順）
+func (t *MessageTemplate) Variables() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, text := range []string{t.Title, t.Body} {
+		for _, match := range templatePlaceholder.FindAllStringSubmatch(text, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				names = append(names, match[1])
+			}
+		}
+	}
+	return names
+}
+
+// Render 変数を埋め込んだタイトル・本文を返す。不足があれば MissingVariablesError
+func (t *MessageTemplate) Render(variables map[string]string) (string, string, error) {
+	var missing []string
+	for _, name := range t.Variables() {
+		if _, ok := variables[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return "", "", &MissingVariablesError{Names: missing}
+	}
+
+	return renderPlaceholders(t.Title, variables), renderPlaceholders(t.Body, variables), nil
+}
+
+// Edit テンプレートを更新
+func (t *MessageTemplate) Edit(name, title, body string) {
+	t.Name = name
+	t.Title = title
+	t.Body = body
+	t.UpdatedAt = time.Now()
+}
+
+func renderPlaceholders(text string, variables map[string]string) string {
+	return templatePlaceholder.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := templatePlaceholder.FindStringSubmatch(placeholder)[1]
+		return variables[name]
+	})
+}
+
+// NewMessageTemplate 新しいテンプレートを作成
+func NewMessageTemplate(name, title, body string) *MessageTemplate {
+	now := time.Now()
+	return &MessageTemplate{
+		ID:        uuid.New(),
+		Name:      name,
+		Title:     title,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// 性別・年齢の条件に使える値（LINEの属性情報フィルター）
+var (
+	NarrowcastGenders = []string{"male", "female"}
+	NarrowcastAges    = []string{"age_15", "age_20", "age_25", "age_30", "age_35", "age_40", "age_45", "age_50", "age_55", "age_60", "age_65", "age_70"}
+)
+
+// NarrowcastMaxAudienceGroups 1回の送信で指定できるオーディエンスの数（本サービスの制限）
+const NarrowcastMaxAudienceGroups = 10
+
+var narrowcastAreaPattern = regexp.MustCompile(`^(jp|tw|th|id)_[0-9]{2}$`)
+
+// NarrowcastAgeRange 年齢の範囲（Gte 以上 Lt 未満、どちらか一方は省略可）
+type NarrowcastAgeRange struct {
+	Gte string `json:"gte,omitempty"`
+	Lt  string `json:"lt,omitempty"`
+}
+
+// NarrowcastFilter 絞り込み配信の条件（JSONBで保存）。指定した条件は全て満たす必要があり、
+// オーディエンスは LINE に登録済みのオーディエンスIDのいずれかに含まれていれば一致する
+type NarrowcastFilter struct {
+	AudienceGroupIDs []int64             `json:"audience_group_ids,omitempty"`
+	Genders          []string            `json:"genders,omitempty"`
+	Ages             *NarrowcastAgeRange `json:"ages,omitempty"`
+	// Areas 地域コード（例: jp_13 は東京都）
+	Areas []string `json:"areas,omitempty"`
+	// Max 送信する最大人数（0 の場合は上限なし）
+	Max int `json:"max,omitempty"`
+}
+
+// HasDemographic 属性情報（性別・年齢・地域）の条件があるかどうか
+func (f *NarrowcastFilter) HasDemographic() bool {
+	return len(f.Genders) > 0 || f.Ages != nil || len(f.Areas) > 0
+}
+
+// Validate 条件の値を検証し、すべてのフィールドエラーを返す
+func (f *NarrowcastFilter) Validate() error {
+	var errs []*FieldError
+	if len(f.AudienceGroupIDs) == 0 && !f.HasDemographic() {
+		errs = append(errs, &FieldError{Message: "must specify audience groups or demographic conditions"})
+	}
+	if len(f.AudienceGroupIDs) > NarrowcastMaxAudienceGroups {
+		errs = append(errs, &FieldError{Field: "audience_group_ids", Message: fmt.Sprintf("must contain at most %d items", NarrowcastMaxAudienceGroups)})
+	}
+	for i, id := range f.AudienceGroupIDs {
+		if id <= 0 {
+			errs = append(errs, &FieldError{Field: fmt.Sprintf("audience_group_ids[%d]", i), Message: "must be a positive audience group ID"})
+		}
+	}
+	for i, gender := range f.Genders {
+		if !slices.Contains(NarrowcastGenders, gender) {
+			errs = append(errs, &FieldError{Field: fmt.Sprintf("genders[%d]", i), Message: fmt.Sprintf("must be one of %v", NarrowcastGenders)})
+		}
+	}
+	if f.Ages != nil {
+		errs = append(errs, FieldErrors(prefixField("ages", f.Ages.Validate()))...)
+	}
+	for i, area := range f.Areas {
+		if !narrowcastAreaPattern.MatchString(area) {
+			errs = append(errs, &FieldError{Field: fmt.Sprintf("areas[%d]", i), Message: "must be an area code such as jp_13"})
+		}
+	}
+	if f.Max < 0 {
+		errs = append(errs, &FieldError{Field: "max", Message: "must not be negative"})
+	}
+	return validationErrors(errs)
+}
+
+// Validate 年齢の値と範囲の向きを検証
+func (r *NarrowcastAgeRange) Validate() error {
+	if r.Gte == "" && r.Lt == "" {
+		return invalidContent("", "must specify gte or lt")
+	}
+	var errs []*FieldError
+	if r.Gte != "" && !slices.Contains(NarrowcastAges, r.Gte) {
+		errs = append(errs, &FieldError{Field: "gte", Message: fmt.Sprintf("must be one of %v", NarrowcastAges)})
+	}
+	if r.Lt != "" && !slices.Contains(NarrowcastAges, r.Lt) {
+		errs = append(errs, &FieldError{Field: "lt", Message: fmt.Sprintf("must be one of %v", NarrowcastAges)})
+	}
+	if len(errs) == 0 && r.Gte != "" && r.Lt != "" && slices.Index(NarrowcastAges, r.Gte) >= slices.Index(NarrowcastAges, r.Lt) {
+		errs = append(errs, &FieldError{Field: "lt", Message: "must be greater than gte"})
+	}
+	return validationErrors(errs)
+}
+
+// Value JSONBとして保存
+func (f NarrowcastFilter) Value() (driver.Value, error) {
+	return json.Marshal(f)
+}
+
+// Scan JSONBから読み込み
+func (f *NarrowcastFilter) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, f)
+	case string:
+		return json.Unmarshal([]byte(v), f)
+	default:
+		return fmt.Errorf("unsupported type for NarrowcastFilter: %T", src)
+	}
+}
+
+// NarrowcastPhase 絞り込み配信の進捗
+type NarrowcastPhase string
+
+const (
+	NarrowcastPhaseWaiting   NarrowcastPhase = "waiting"
+	NarrowcastPhaseSending   NarrowcastPhase = "sending"
+	NarrowcastPhaseSucceeded NarrowcastPhase = "succeeded"
+	NarrowcastPhaseFailed    NarrowcastPhase = "failed"
+)
+
+// NarrowcastProgress LINEから取得した絞り込み配信の進捗
+type NarrowcastProgress struct {
+	Phase             NarrowcastPhase
+	SuccessCount      int
+	FailureCount      int
+	TargetCount       int
+	FailedDescription string
+}
+
+// IsDone 送信が完了（成功・失敗）したかどうか
+func (p *NarrowcastProgress) IsDone() bool {
+	return p.Phase == NarrowcastPhaseSucceeded || p.Phase == NarrowcastPhaseFailed
+}
+
+// Counts 最終的な送信数
+func (p *NarrowcastProgress) Counts() *DeliveryCounts {
+	return &DeliveryCounts{Target: p.TargetCount, Success: p.SuccessCount, Failure: p.FailureCount}
+}
+
+// DeliveryCounts 送信対象数と成功・失敗数（JSONBで保存）
+type DeliveryCounts struct {
+	Target  int `json:"target"`
+	Success int `json:"success"`
+	Failure int `json:"failure"`
+}
+
+// Value JSONBとして保存
+func (c DeliveryCounts) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan JSONBから読み込み
+func (c *DeliveryCounts) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("unsupported type for DeliveryCounts: %T", src)
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// QuickReplyMaxItems LINEのクイックリプライは最大13項目
+const QuickReplyMaxItems = 13
+
+// QuickReplyItem クイックリプライのボタン1つ分
+type QuickReplyItem struct {
+	// ImageURL ボタンに表示するアイコン（任意）
+	ImageURL string `json:"image_url,omitempty"`
+	Action   Action `json:"action"`
+}
+
+// QuickReplyItems メッセージに添付するクイックリプライ（JSONBで保存）
+type QuickReplyItems []QuickReplyItem
+
+var quickReplyActionTypes = []ActionType{
+	ActionTypeMessage,
+	ActionTypePostback,
+	ActionTypeDatetimePicker,
+	ActionTypeCamera,
+	ActionTypeCameraRoll,
+	ActionTypeLocation,
+}
+
+// Validate 項目数・アクション種別・アイコンURLを検証し、すべてのフィールドエラーを返す
+func (items QuickReplyItems) Validate() error {
+	if len(items) > QuickReplyMaxItems {
+		return invalidContent("quick_reply", fmt.Sprintf("must contain at most %d items", QuickReplyMaxItems))
+	}
+
+	var errs []*FieldError
+	for i, item := range items {
+		errs = append(errs, FieldErrors(prefixField(fmt.Sprintf("quick_reply[%d]", i), item.Validate()))...)
+	}
+	return validationErrors(errs)
+}
+
+// Validate アクション種別・アイコンURLを検証
+func (item *QuickReplyItem) Validate() error {
+	if !slices.Contains(quickReplyActionTypes, item.Action.Type) {
+		return invalidContent("action.type", fmt.Sprintf("%q is not available in quick replies", item.Action.Type))
+	}
+	if item.ImageURL != "" {
+		if err := validateMediaURL("image_url", item.ImageURL); err != nil {
+			return err
+		}
+	}
+	return item.Action.Validate("action", ActionLabelMaxLength)
+}
+
+// Value JSONBとして保存（空の場合はNULL）
+func (items QuickReplyItems) Value() (driver.Value, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(items)
+}
+
+// Scan JSONBから読み込み
+func (items *QuickReplyItems) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*items = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, items)
+	case string:
+		return json.Unmarshal([]byte(v), items)
+	default:
+		return fmt.Errorf("unsupported type for QuickReplyItems: %T", src)
+	}
+}
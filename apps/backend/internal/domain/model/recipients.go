@@ -0,0 +1,72 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// MulticastMaxRecipients LINEのマルチキャストは1リクエストあたり最大500ユーザー
+const MulticastMaxRecipients = 500
+
+var lineUserIDPattern = regexp.MustCompile(`^U[0-9a-f]{32}$`)
+
+// Recipients 送信先のLINEユーザーID（JSONBで保存）
+type Recipients []string
+
+// Validate 各ユーザーIDの形式を検証し、すべてのフィールドエラーを返す
+func (r Recipients) Validate() error {
+	var errs []*FieldError
+	for i, userID := range r {
+		if !lineUserIDPattern.MatchString(userID) {
+			errs = append(errs, &FieldError{Field: fmt.Sprintf("recipients[%d]", i), Message: "must be a LINE user ID"})
+		}
+	}
+	return validationErrors(errs)
+}
+
+// Unique 重複を除いた送信先（順番は最初に現れた順）
+func (r Recipients) Unique() Recipients {
+	seen := make(map[string]bool, len(r))
+	unique := make(Recipients, 0, len(r))
+	for _, userID := range r {
+		if !seen[userID] {
+			seen[userID] = true
+			unique = append(unique, userID)
+		}
+	}
+	return unique
+}
+
+// Chunk size 件ずつに分割する
+func (r Recipients) Chunk(size int) []Recipients {
+	var chunks []Recipients
+	for start := 0; start < len(r); start += size {
+		chunks = append(chunks, r[start:min(start+size, len(r))])
+	}
+	return chunks
+}
+
+// Value JSONBとして保存（空の場合はNULL）
+func (r Recipients) Value() (driver.Value, error) {
+	if len(r) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(r)
+}
+
+// Scan JSONBから読み込み
+func (r *Recipients) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*r = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("unsupported type for Recipients: %T", src)
+	}
+}
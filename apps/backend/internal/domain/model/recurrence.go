@@ -0,0 +1,69 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRecurrence 繰り返しルールが不正（errors.Is で判定可能）
+var ErrInvalidRecurrence = errors.New("invalid recurrence")
+
+// recurrenceSearchDays 次回日時を探索する最大日数（閏年の2/29指定も拾えるよう5年分）
+const recurrenceSearchDays = 5 * 366
+
+// Recurrence 繰り返しルール（cron式 / iCal RRULE）
+type Recurrence interface {
+	// Next after より後の次回発生日時。以降発生しない場合はゼロ値
+	Next(after time.Time) time.Time
+}
+
+// ParseRecurrence cron式（5フィールド）または RRULE を解析する。
+// start は RRULE の起点（DTSTART）で、INTERVAL や COUNT の基準になる
+func ParseRecurrence(expression string, loc *time.Location, start time.Time) (Recurrence, error) {
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return nil, invalidRecurrence("expression is required")
+	}
+	upper := strings.ToUpper(expression)
+	if strings.HasPrefix(upper, "RRULE:") || strings.Contains(upper, "FREQ=") {
+		return parseRRule(strings.TrimPrefix(upper, "RRULE:"), start.In(loc))
+	}
+	return parseCron(expression, loc)
+}
+
+// Occurrences after より後の発生日時を最大 n 件返す
+func Occurrences(r Recurrence, after time.Time, n int) []time.Time {
+	var times []time.Time
+	for len(times) < n {
+		next := r.Next(after)
+		if next.IsZero() {
+			break
+		}
+		times = append(times, next)
+		after = next
+	}
+	return times
+}
+
+// dayTimes 指定日の hours × minutes の日時のうち after より後のものを昇順で返す。
+// 夏時間の切り替え日も LocalTime で解決するため、同じ壁時計の時刻が2回配信されることはない
+func dayTimes(day time.Time, hours, minutes []int, after time.Time) []time.Time {
+	var times []time.Time
+	for _, h := range hours {
+		for _, m := range minutes {
+			t := LocalTime(day.Year(), day.Month(), day.Day(), h, m, 0, day.Location())
+			if t.After(after) && !slices.ContainsFunc(times, t.Equal) {
+				times = append(times, t)
+			}
+		}
+	}
+	slices.SortFunc(times, func(a, b time.Time) int { return a.Compare(b) })
+	return times
+}
+
+func invalidRecurrence(reason string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRecurrence, reason)
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecurringScheduleStatus string
+
+const (
+	RecurringScheduleStatusActive RecurringScheduleStatus = "active"
+	RecurringScheduleStatusPaused RecurringScheduleStatus = "paused"
+)
+
+var (
+	// ErrAlreadyPaused 既に一時停止中
+	ErrAlreadyPaused = errors.New("recurring schedule is already paused")
+	// ErrNotPaused 一時停止中ではない
+	ErrNotPaused = errors.New("recurring schedule is not paused")
+)
+
+// RecurringSchedule 繰り返し配信の定義。MessageID のメッセージを雛形として、発生日時ごとに配信用メッセージを作成する
+type RecurringSchedule struct {
+	ID         uuid.UUID               `json:"id" db:"id"`
+	MessageID  uuid.UUID               `json:"message_id" db:"message_id"`
+	Expression string                  `json:"expression" db:"expression"`
+	Timezone   string                  `json:"timezone" db:"timezone"`
+	Status     RecurringScheduleStatus `json:"status" db:"status"`
+	// StartsAt RRULE の起点（DTSTART）。これより前には発生しない
+	StartsAt time.Time `json:"starts_at" db:"starts_at"`
+	// NextRunAt 次回発生日時（終了した場合は nil）
+	NextRunAt *time.Time `json:"next_run_at,omitempty" db:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty" db:"last_run_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// Recurrence 繰り返しルールを解析
+func (s *RecurringSchedule) Recurrence() (Recurrence, error) {
+	loc, err := LoadTimezone(s.Timezone)
+	if err != nil {
+		return nil, invalidRecurrence(err.Error())
+	}
+	return ParseRecurrence(s.Expression, loc, s.StartsAt)
+}
+
+// Occurrences after より後の発生日時を最大 n 件返す（タイムゾーンのローカル時刻）
+func (s *RecurringSchedule) Occurrences(after time.Time, n int) ([]time.Time, error) {
+	recurrence, err := s.Recurrence()
+	if err != nil {
+		return nil, err
+	}
+	return Occurrences(recurrence, after, n), nil
+}
+
+// IsDue now 時点で配信すべき発生日時があるか
+func (s *RecurringSchedule) IsDue(now time.Time) bool {
+	return s.Status == RecurringScheduleStatusActive && s.NextRunAt != nil && !s.NextRunAt.After(now)
+}
+
+// Advance 今回の発生を記録し、now より後の次回日時へ進める（停止中などで取りこぼした回はまとめて送らない）
+func (s *RecurringSchedule) Advance(now time.Time) error {
+	recurrence, err := s.Recurrence()
+	if err != nil {
+		return err
+	}
+	s.LastRunAt = s.NextRunAt
+	s.NextRunAt = nextRunAt(recurrence, now)
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Pause 一時停止
+func (s *RecurringSchedule) Pause() error {
+	if s.Status == RecurringScheduleStatusPaused {
+		return ErrAlreadyPaused
+	}
+	s.Status = RecurringScheduleStatusPaused
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Resume 再開。停止中に過ぎた回は送らず、now より後の次回日時から再開する
+func (s *RecurringSchedule) Resume(now time.Time) error {
+	if s.Status != RecurringScheduleStatusPaused {
+		return ErrNotPaused
+	}
+	recurrence, err := s.Recurrence()
+	if err != nil {
+		return err
+	}
+	s.Status = RecurringScheduleStatusActive
+	s.NextRunAt = nextRunAt(recurrence, now)
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+func nextRunAt(recurrence Recurrence, after time.Time) *time.Time {
+	next := recurrence.Next(after)
+	if next.IsZero() {
+		return nil
+	}
+	utc := next.UTC()
+	return &utc
+}
+
+// NewRecurringSchedule 繰り返し配信を作成。発生日時は timezone の壁時計で評価する（夏時間にも追従）。
+// startsAt がゼロ値の場合は now を起点とする
+func NewRecurringSchedule(messageID uuid.UUID, expression, timezone string, startsAt, now time.Time) (*RecurringSchedule, error) {
+	if timezone == "" {
+		timezone = DefaultWorkspaceTimezone
+	}
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+
+	schedule := &RecurringSchedule{
+		ID:         uuid.New(),
+		MessageID:  messageID,
+		Expression: expression,
+		Timezone:   timezone,
+		Status:     RecurringScheduleStatusActive,
+		StartsAt:   startsAt.Truncate(time.Minute),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	recurrence, err := schedule.Recurrence()
+	if err != nil {
+		return nil, err
+	}
+	schedule.NextRunAt = nextRunAt(recurrence, now)
+	if schedule.NextRunAt == nil {
+		return nil, invalidRecurrence("expression has no upcoming occurrences")
+	}
+
+	return schedule, nil
+}
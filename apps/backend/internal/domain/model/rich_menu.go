@@ -0,0 +1,268 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrLineRichMenuNotFound LINE上にリッチメニューが存在しない（削除済み・チャネルのリセット後など）
+var ErrLineRichMenuNotFound = errors.New("rich menu not found on LINE")
+
+// リッチメニューの制限値（LINE Messaging API の仕様）
+const (
+	RichMenuMinWidth             = 800
+	RichMenuMaxWidth             = 2500
+	RichMenuMinHeight            = 250
+	RichMenuMinAspectRatio       = 1.45
+	RichMenuMaxAreas             = 20
+	RichMenuNameMaxLength        = 300
+	RichMenuChatBarTextMaxLength = 14
+	// RichMenuImageMaxBytes 画像（JPEG / PNG）の最大サイズ
+	RichMenuImageMaxBytes = 1 << 20
+	// RichMenuLinkMaxUsers 一括リンク・リンク解除は1リクエストあたり最大500ユーザー
+	RichMenuLinkMaxUsers = 500
+)
+
+// richMenuImageTypes アップロードできる画像の Content-Type
+var richMenuImageTypes = []string{"image/jpeg", "image/png"}
+
+// RichMenuSize リッチメニュー画像のサイズ（px）
+type RichMenuSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Validate LINEが受け付けるサイズかどうかを検証
+func (s RichMenuSize) Validate() error {
+	var errs []*FieldError
+	if s.Width < RichMenuMinWidth || s.Width > RichMenuMaxWidth {
+		errs = append(errs, &FieldError{Field: "width", Message: fmt.Sprintf("must be between %d and %d", RichMenuMinWidth, RichMenuMaxWidth)})
+	}
+	if s.Height < RichMenuMinHeight {
+		errs = append(errs, &FieldError{Field: "height", Message: fmt.Sprintf("must be at least %d", RichMenuMinHeight)})
+	} else if float64(s.Width)/float64(s.Height) < RichMenuMinAspectRatio {
+		errs = append(errs, &FieldError{Field: "height", Message: fmt.Sprintf("must keep width / height at least %.2f", RichMenuMinAspectRatio)})
+	}
+	return validationErrors(errs)
+}
+
+// Value JSONBとして保存
+func (s RichMenuSize) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan JSONBから読み込み
+func (s *RichMenuSize) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("unsupported type for RichMenuSize: %T", src)
+	}
+}
+
+// RichMenuBounds タップ領域（画像左上を原点とするpx）
+type RichMenuBounds struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// RichMenuArea タップ領域とタップ時のアクション
+type RichMenuArea struct {
+	Bounds RichMenuBounds `json:"bounds"`
+	Action Action         `json:"action"`
+}
+
+// validate 領域がメニュー内に収まっているか、アクションが有効かを検証
+func (a *RichMenuArea) validate(size RichMenuSize) error {
+	var errs []*FieldError
+	b := a.Bounds
+	if b.X < 0 || b.Y < 0 || b.Width <= 0 || b.Height <= 0 {
+		errs = append(errs, &FieldError{Field: "bounds", Message: "must have a non-negative position and a positive size"})
+	} else if b.X+b.Width > size.Width || b.Y+b.Height > size.Height {
+		errs = append(errs, &FieldError{Field: "bounds", Message: "must fit within the menu size"})
+	}
+
+	// リッチメニューではラベルは任意（読み上げ用）。クイックリプライ専用のアクションは使えない
+	switch {
+	case a.Action.IsQuickReplyOnly():
+		errs = append(errs, &FieldError{Field: "action.type", Message: fmt.Sprintf("%q is not supported in rich menus", a.Action.Type)})
+	case UTF16Length(a.Action.Label) > ActionLabelMaxLength:
+		errs = append(errs, &FieldError{Field: "action.label", Message: fmt.Sprintf("must be at most %d characters", ActionLabelMaxLength)})
+	default:
+		errs = append(errs, FieldErrors(a.Action.validatePayload("action"))...)
+	}
+	return validationErrors(errs)
+}
+
+// RichMenuAreas タップ領域の一覧（JSONBで保存）
+type RichMenuAreas []RichMenuArea
+
+// Value JSONBとして保存
+func (a RichMenuAreas) Value() (driver.Value, error) {
+	if a == nil {
+		a = RichMenuAreas{}
+	}
+	return json.Marshal(a)
+}
+
+// Scan JSONBから読み込み
+func (a *RichMenuAreas) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return fmt.Errorf("unsupported type for RichMenuAreas: %T", src)
+	}
+}
+
+// RichMenuDefinition LINEに登録するリッチメニューの定義（LINE上では変更できないため、変更時は作り直す）
+type RichMenuDefinition struct {
+	Name        string        `json:"name" db:"name"`
+	ChatBarText string        `json:"chat_bar_text" db:"chat_bar_text"`
+	Size        RichMenuSize  `json:"size" db:"size"`
+	Areas       RichMenuAreas `json:"areas" db:"areas"`
+	// Selected トーク画面を開いたときにメニューを開いた状態で表示するかどうか
+	Selected bool `json:"selected" db:"selected"`
+}
+
+// Validate 定義全体を検証し、すべてのフィールドエラーを返す
+func (d *RichMenuDefinition) Validate() error {
+	var errs []*FieldError
+
+	if strings.TrimSpace(d.Name) == "" {
+		errs = append(errs, &FieldError{Field: "name", Message: "is required"})
+	} else if UTF16Length(d.Name) > RichMenuNameMaxLength {
+		errs = append(errs, &FieldError{Field: "name", Message: fmt.Sprintf("must be at most %d characters", RichMenuNameMaxLength)})
+	}
+	if strings.TrimSpace(d.ChatBarText) == "" {
+		errs = append(errs, &FieldError{Field: "chat_bar_text", Message: "is required"})
+	} else if UTF16Length(d.ChatBarText) > RichMenuChatBarTextMaxLength {
+		errs = append(errs, &FieldError{Field: "chat_bar_text", Message: fmt.Sprintf("must be at most %d characters", RichMenuChatBarTextMaxLength)})
+	}
+
+	sizeErr := d.Size.Validate()
+	errs = append(errs, FieldErrors(prefixField("size", sizeErr))...)
+
+	switch {
+	case len(d.Areas) == 0:
+		errs = append(errs, &FieldError{Field: "areas", Message: "must contain at least 1 item"})
+	case len(d.Areas) > RichMenuMaxAreas:
+		errs = append(errs, &FieldError{Field: "areas", Message: fmt.Sprintf("must contain at most %d items", RichMenuMaxAreas)})
+	}
+	// サイズが不正な場合、領域がメニュー内に収まるかは判定できない
+	if sizeErr == nil {
+		for i := range d.Areas {
+			errs = append(errs, FieldErrors(prefixField(fmt.Sprintf("areas[%d]", i), d.Areas[i].validate(d.Size)))...)
+		}
+	}
+
+	return validationErrors(errs)
+}
+
+// RichMenu DBで管理するリッチメニュー。定義を変更するたびに Revision を進め、LINE上のリッチメニューを作り直す
+type RichMenu struct {
+	ID uuid.UUID `json:"id" db:"id"`
+	RichMenuDefinition
+	Revision int `json:"revision" db:"revision"`
+	// LineRichMenuID LINE上のリッチメニューID（LINEに登録していない場合は空）
+	LineRichMenuID string `json:"line_rich_menu_id" db:"line_rich_menu_id"`
+	// IsDefault 個別にリンクしていない友だち全員に表示する既定のリッチメニューかどうか
+	IsDefault bool `json:"is_default" db:"is_default"`
+	// Image 登録済みの画像（LINEへの再登録用。一覧取得では読み込まない）
+	Image []byte `json:"-" db:"image"`
+	// ImageContentType 画像の Content-Type（画像が未登録の場合は空）
+	ImageContentType string `json:"image_content_type" db:"image_content_type"`
+	// AppliedAt 最後にLINEへ登録した日時
+	AppliedAt *time.Time `json:"applied_at" db:"applied_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// NewRichMenu 新しいリッチメニューを作成（LINEへの登録前の状態）
+func NewRichMenu(definition RichMenuDefinition) (*RichMenu, error) {
+	definition.Name = strings.TrimSpace(definition.Name)
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &RichMenu{
+		ID:                 uuid.New(),
+		RichMenuDefinition: definition,
+		Revision:           1,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}, nil
+}
+
+// SetDefinition 定義を置き換えて Revision を進める（LINEへは作り直して反映する必要がある）
+func (m *RichMenu) SetDefinition(definition RichMenuDefinition) error {
+	definition.Name = strings.TrimSpace(definition.Name)
+	if err := definition.Validate(); err != nil {
+		return err
+	}
+	m.RichMenuDefinition = definition
+	m.Revision++
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// HasImage 画像が登録済みかどうか（画像がないリッチメニューは既定に設定・リンクできない）
+func (m *RichMenu) HasImage() bool {
+	return m.ImageContentType != ""
+}
+
+// SetImage 画像を設定する
+func (m *RichMenu) SetImage(contentType string, image []byte) error {
+	if err := ValidateRichMenuImage(contentType, image); err != nil {
+		return err
+	}
+	m.ImageContentType = contentType
+	m.Image = image
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// MarkApplied LINE上に作成したリッチメニューIDを記録する
+func (m *RichMenu) MarkApplied(lineRichMenuID string, at time.Time) {
+	m.LineRichMenuID = lineRichMenuID
+	m.AppliedAt = &at
+	m.UpdatedAt = at
+}
+
+// ValidateRichMenuImage 画像の形式とサイズを検証
+func ValidateRichMenuImage(contentType string, image []byte) error {
+	var errs []*FieldError
+	if !slices.Contains(richMenuImageTypes, contentType) {
+		errs = append(errs, &FieldError{Field: "content_type", Message: "must be image/jpeg or image/png"})
+	}
+	switch {
+	case len(image) == 0:
+		errs = append(errs, &FieldError{Field: "image", Message: "is required"})
+	case len(image) > RichMenuImageMaxBytes:
+		errs = append(errs, &FieldError{Field: "image", Message: fmt.Sprintf("must be at most %d bytes", RichMenuImageMaxBytes)})
+	}
+	return validationErrors(errs)
+}
+
+// RichMenuRevision リッチメニューの定義の履歴（Revision ごとに記録する）
+type RichMenuRevision struct {
+	ID         uuid.UUID `json:"id" db:"id"`
+	RichMenuID uuid.UUID `json:"rich_menu_id" db:"rich_menu_id"`
+	Revision   int       `json:"revision" db:"revision"`
+	RichMenuDefinition
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,298 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// rruleWeekday BYDAY の要素。Ordinal は月内の第n週（負数は末尾から、0は毎週）
+type rruleWeekday struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// rrule iCal RRULE（RFC 5545）のうち配信予約で使う範囲をサポートする。
+// FREQ=DAILY/WEEKLY/MONTHLY/YEARLY, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, BYHOUR, BYMINUTE, COUNT, UNTIL
+type rrule struct {
+	freq       string
+	interval   int
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byMonth    []int
+	hours      []int
+	minutes    []int
+	count      int
+	until      time.Time
+	start      time.Time
+}
+
+func parseRRule(rule string, start time.Time) (*rrule, error) {
+	r := &rrule{interval: 1, start: start}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, invalidRecurrence(fmt.Sprintf("invalid RRULE part %q", part))
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			if !slices.Contains([]string{"DAILY", "WEEKLY", "MONTHLY", "YEARLY"}, value) {
+				return nil, invalidRecurrence(fmt.Sprintf("FREQ %q is not supported", value))
+			}
+			r.freq = value
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err != nil || r.interval <= 0 {
+				return nil, invalidRecurrence("INTERVAL must be a positive integer")
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(value)
+			if err != nil || r.count <= 0 {
+				return nil, invalidRecurrence("COUNT must be a positive integer")
+			}
+		case "UNTIL":
+			if r.until, err = parseRRuleUntil(value, start.Location()); err != nil {
+				return nil, err
+			}
+		case "BYDAY":
+			if r.byDay, err = parseRRuleByDay(value); err != nil {
+				return nil, err
+			}
+		case "BYMONTHDAY":
+			if r.byMonthDay, err = parseRRuleInts(key, value, -31, 31); err != nil {
+				return nil, err
+			}
+		case "BYMONTH":
+			if r.byMonth, err = parseRRuleInts(key, value, 1, 12); err != nil {
+				return nil, err
+			}
+		case "BYHOUR":
+			if r.hours, err = parseRRuleInts(key, value, 0, 23); err != nil {
+				return nil, err
+			}
+		case "BYMINUTE":
+			if r.minutes, err = parseRRuleInts(key, value, 0, 59); err != nil {
+				return nil, err
+			}
+		case "WKST":
+			// 週の開始は月曜固定
+		default:
+			return nil, invalidRecurrence(fmt.Sprintf("RRULE part %s is not supported", key))
+		}
+	}
+
+	if r.freq == "" {
+		return nil, invalidRecurrence("FREQ is required")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, invalidRecurrence("COUNT and UNTIL cannot be used together")
+	}
+	// 時刻の指定がなければ起点の時刻を使う
+	if r.hours == nil {
+		r.hours = []int{start.Hour()}
+	}
+	if r.minutes == nil {
+		r.minutes = []int{start.Minute()}
+	}
+	return r, nil
+}
+
+func (r *rrule) Next(after time.Time) time.Time {
+	if r.count == 0 {
+		return r.next(after)
+	}
+
+	// COUNT は起点から数えるため、先頭から順に辿る
+	t := r.start.Add(-time.Nanosecond)
+	for i := 0; i < r.count; i++ {
+		t = r.next(t)
+		if t.IsZero() {
+			return t
+		}
+		if t.After(after) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// next COUNT を考慮しない次回日時
+func (r *rrule) next(after time.Time) time.Time {
+	loc := r.start.Location()
+	after = after.In(loc)
+	if after.Before(r.start) {
+		after = r.start.Add(-time.Nanosecond)
+	}
+
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, loc)
+	for i := 0; i < recurrenceSearchDays; i++ {
+		if r.matchesDay(day) {
+			if times := dayTimes(day, r.hours, r.minutes, after); len(times) > 0 {
+				if !r.until.IsZero() && times[0].After(r.until) {
+					return time.Time{}
+				}
+				return times[0]
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+func (r *rrule) matchesDay(day time.Time) bool {
+	if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, int(day.Month())) {
+		return false
+	}
+
+	switch r.freq {
+	case "DAILY":
+		if calendarDaysBetween(r.start, day)%r.interval != 0 {
+			return false
+		}
+		return r.matchesMonthDay(day) && r.matchesWeekday(day, false)
+	case "WEEKLY":
+		weeks := calendarDaysBetween(weekStart(r.start), weekStart(day)) / 7
+		if weeks%r.interval != 0 {
+			return false
+		}
+		if len(r.byDay) == 0 {
+			return day.Weekday() == r.start.Weekday()
+		}
+		return r.matchesWeekday(day, false)
+	case "MONTHLY":
+		if monthsBetween(r.start, day)%r.interval != 0 {
+			return false
+		}
+		return r.matchesDayInMonth(day)
+	case "YEARLY":
+		if (day.Year()-r.start.Year())%r.interval != 0 {
+			return false
+		}
+		if len(r.byMonth) == 0 && day.Month() != r.start.Month() {
+			return false
+		}
+		return r.matchesDayInMonth(day)
+	}
+	return false
+}
+
+// matchesDayInMonth BYMONTHDAY / BYDAY の指定がなければ起点と同じ日
+func (r *rrule) matchesDayInMonth(day time.Time) bool {
+	if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		return day.Day() == r.start.Day()
+	}
+	return r.matchesMonthDay(day) && r.matchesWeekday(day, true)
+}
+
+// matchesMonthDay BYMONTHDAY（負数は月末から数える）。未指定なら常に一致
+func (r *rrule) matchesMonthDay(day time.Time) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+	last := daysInMonth(day)
+	for _, d := range r.byMonthDay {
+		if d == day.Day() || (d < 0 && last+d+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday BYDAY。withinMonth の場合は第n曜日（1MO, -1FR など）を評価する。未指定なら常に一致
+func (r *rrule) matchesWeekday(day time.Time, withinMonth bool) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, wd := range r.byDay {
+		if wd.Weekday != day.Weekday() {
+			continue
+		}
+		if wd.Ordinal == 0 || !withinMonth {
+			return true
+		}
+		if wd.Ordinal > 0 && (day.Day()-1)/7+1 == wd.Ordinal {
+			return true
+		}
+		if wd.Ordinal < 0 && (daysInMonth(day)-day.Day())/7+1 == -wd.Ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+func parseRRuleByDay(value string) ([]rruleWeekday, error) {
+	var days []rruleWeekday
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, invalidRecurrence(fmt.Sprintf("invalid BYDAY value %q", item))
+		}
+		weekday := slices.Index(rruleWeekdays, item[len(item)-2:])
+		if weekday < 0 {
+			return nil, invalidRecurrence(fmt.Sprintf("invalid BYDAY value %q", item))
+		}
+		ordinal := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, invalidRecurrence(fmt.Sprintf("invalid BYDAY value %q", item))
+			}
+			ordinal = n
+		}
+		days = append(days, rruleWeekday{Ordinal: ordinal, Weekday: time.Weekday(weekday)})
+	}
+	return days, nil
+}
+
+func parseRRuleInts(key, value string, min, max int) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n < min || n > max || (min < 0 && n == 0) {
+			return nil, invalidRecurrence(fmt.Sprintf("%s value %q is out of range", key, item))
+		}
+		values = append(values, n)
+	}
+	slices.Sort(values)
+	return values, nil
+}
+
+// parseRRuleUntil "20240131T150000Z"（UTC）、"20240131T150000"（ローカル）、"20240131"（その日の終わり）
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	return time.Time{}, invalidRecurrence(fmt.Sprintf("invalid UNTIL value %q", value))
+}
+
+// calendarDaysBetween 暦日ベースの日数差（夏時間で1日が23/25時間になっても正しく数える）
+func calendarDaysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// weekStart その週の月曜日
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
+
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// タグの制限値
+const (
+	TagNameMaxLength = 50
+	MessageMaxTags   = 20
+	DefaultTagColor  = "#9E9E9E"
+)
+
+// ErrInvalidTag タグ名・色が不正（errors.Is で判定可能）
+var ErrInvalidTag = errors.New("invalid tag")
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Tag メッセージを企画・シリーズ単位でまとめるラベル（メッセージとは多対多）
+type Tag struct {
+	ID    uuid.UUID `json:"id" db:"id"`
+	Name  string    `json:"name" db:"name"`
+	Color string    `json:"color" db:"color"`
+	// UsageCount このタグが付いたメッセージ数（ゴミ箱にあるものを除く。一覧取得時のみ集計）
+	UsageCount int       `json:"usage_count" db:"usage_count"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Edit 名前と色を変更（色が空の場合は既定色）
+func (t *Tag) Edit(name, color string) error {
+	name, color, err := normalizeTag(name, color)
+	if err != nil {
+		return err
+	}
+	t.Name = name
+	t.Color = color
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// normalizeTag 前後の空白を除いた名前と、大文字に揃えた色を返す
+func normalizeTag(name, color string) (string, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", "", fmt.Errorf("%w: name is required", ErrInvalidTag)
+	}
+	if utf8.RuneCountInString(name) > TagNameMaxLength {
+		return "", "", fmt.Errorf("%w: name must be at most %d characters", ErrInvalidTag, TagNameMaxLength)
+	}
+	if color == "" {
+		color = DefaultTagColor
+	}
+	if !tagColorPattern.MatchString(color) {
+		return "", "", fmt.Errorf("%w: color must be a hex color such as #FF8800", ErrInvalidTag)
+	}
+	return name, strings.ToUpper(color), nil
+}
+
+// NewTag 新しいタグを作成
+func NewTag(name, color string) (*Tag, error) {
+	name, color, err := normalizeTag(name, color)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &Tag{
+		ID:        uuid.New(),
+		Name:      name,
+		Color:     color,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
@@ -0,0 +1,195 @@
+package model
+
+import "fmt"
+
+type TemplateType string
+
+const (
+	TemplateTypeButtons       TemplateType = "buttons"
+	TemplateTypeConfirm       TemplateType = "confirm"
+	TemplateTypeCarousel      TemplateType = "carousel"
+	TemplateTypeImageCarousel TemplateType = "image_carousel"
+)
+
+// LINE テンプレートメッセージの制限値
+const (
+	TemplateAltTextMaxLength            = 400
+	TemplateTitleMaxLength              = 40
+	TemplateButtonsTextMaxLength        = 160
+	TemplateConfirmTextMaxLength        = 240
+	TemplateCarouselTextMaxLength       = 120
+	TemplateTextWithHeaderMaxLength     = 60
+	TemplateButtonsMaxActions           = 4
+	TemplateCarouselMaxActions          = 3
+	TemplateCarouselMaxColumns          = 10
+	TemplateImageCarouselLabelMaxLength = 12
+)
+
+// TemplateContent テンプレートメッセージ。
+// buttons / confirm は Actions、carousel / image_carousel は Columns を使用する
+type TemplateContent struct {
+	Type              TemplateType     `json:"type"`
+	AltText           string           `json:"alt_text"`
+	ThumbnailImageURL string           `json:"thumbnail_image_url,omitempty"`
+	Title             string           `json:"title,omitempty"`
+	Text              string           `json:"text,omitempty"`
+	DefaultAction     *Action          `json:"default_action,omitempty"`
+	Actions           []Action         `json:"actions,omitempty"`
+	Columns           []TemplateColumn `json:"columns,omitempty"`
+}
+
+// TemplateColumn carousel / image_carousel の列。image_carousel は ImageURL と Action のみ使用する
+type TemplateColumn struct {
+	ThumbnailImageURL string   `json:"thumbnail_image_url,omitempty"`
+	Title             string   `json:"title,omitempty"`
+	Text              string   `json:"text,omitempty"`
+	DefaultAction     *Action  `json:"default_action,omitempty"`
+	Actions           []Action `json:"actions,omitempty"`
+	ImageURL          string   `json:"image_url,omitempty"`
+	Action            *Action  `json:"action,omitempty"`
+}
+
+// Validate テンプレート種別ごとの構造とLINEの制限値を検証
+func (t *TemplateContent) Validate() error {
+	if t.AltText == "" {
+		return invalidContent("alt_text", "is required")
+	}
+	if UTF16Length(t.AltText) > TemplateAltTextMaxLength {
+		return invalidContent("alt_text", fmt.Sprintf("must be at most %d characters", TemplateAltTextMaxLength))
+	}
+
+	switch t.Type {
+	case TemplateTypeButtons:
+		return t.validateButtons()
+	case TemplateTypeConfirm:
+		return t.validateConfirm()
+	case TemplateTypeCarousel:
+		return t.validateCarousel()
+	case TemplateTypeImageCarousel:
+		return t.validateImageCarousel()
+	default:
+		return invalidContent("type", fmt.Sprintf("%q is not supported", t.Type))
+	}
+}
+
+func (t *TemplateContent) validateButtons() error {
+	if err := validateTemplateBody("", t.ThumbnailImageURL, t.Title, t.Text, TemplateButtonsTextMaxLength); err != nil {
+		return err
+	}
+	if err := validateTemplateActions("actions", t.Actions, 1, TemplateButtonsMaxActions); err != nil {
+		return err
+	}
+	return validateDefaultAction("default_action", t.DefaultAction)
+}
+
+func (t *TemplateContent) validateConfirm() error {
+	if t.Text == "" {
+		return invalidContent("text", "is required")
+	}
+	if UTF16Length(t.Text) > TemplateConfirmTextMaxLength {
+		return invalidContent("text", fmt.Sprintf("must be at most %d characters", TemplateConfirmTextMaxLength))
+	}
+	return validateTemplateActions("actions", t.Actions, 2, 2)
+}
+
+func (t *TemplateContent) validateCarousel() error {
+	if len(t.Columns) == 0 || len(t.Columns) > TemplateCarouselMaxColumns {
+		return invalidContent("columns", fmt.Sprintf("must contain 1 to %d columns", TemplateCarouselMaxColumns))
+	}
+
+	for i, column := range t.Columns {
+		path := fmt.Sprintf("columns[%d]", i)
+		if err := validateTemplateBody(path, column.ThumbnailImageURL, column.Title, column.Text, TemplateCarouselTextMaxLength); err != nil {
+			return err
+		}
+		if err := validateTemplateActions(path+".actions", column.Actions, 1, TemplateCarouselMaxActions); err != nil {
+			return err
+		}
+		// LINEの仕様上、全列のアクション数は揃える必要がある
+		if len(column.Actions) != len(t.Columns[0].Actions) {
+			return invalidContent("columns", "must all have the same number of actions")
+		}
+		if err := validateDefaultAction(path+".default_action", column.DefaultAction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TemplateContent) validateImageCarousel() error {
+	if len(t.Columns) == 0 || len(t.Columns) > TemplateCarouselMaxColumns {
+		return invalidContent("columns", fmt.Sprintf("must contain 1 to %d columns", TemplateCarouselMaxColumns))
+	}
+
+	for i, column := range t.Columns {
+		path := fmt.Sprintf("columns[%d]", i)
+		if err := validateMediaURL(path+".image_url", column.ImageURL); err != nil {
+			return err
+		}
+		if column.Action == nil {
+			return invalidContent(path+".action", "is required")
+		}
+		if column.Action.IsQuickReplyOnly() {
+			return invalidContent(path+".action.type", fmt.Sprintf("%q is only available in quick replies", column.Action.Type))
+		}
+		if err := column.Action.Validate(path+".action", TemplateImageCarouselLabelMaxLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTemplateBody サムネイル・タイトル・本文を検証。画像かタイトルがある場合は本文の上限が下がる
+func validateTemplateBody(path, thumbnailURL, title, text string, maxText int) error {
+	if thumbnailURL != "" {
+		if err := validateMediaURL(fieldPath(path, "thumbnail_image_url"), thumbnailURL); err != nil {
+			return err
+		}
+	}
+	if UTF16Length(title) > TemplateTitleMaxLength {
+		return invalidContent(fieldPath(path, "title"), fmt.Sprintf("must be at most %d characters", TemplateTitleMaxLength))
+	}
+	if text == "" {
+		return invalidContent(fieldPath(path, "text"), "is required")
+	}
+	if thumbnailURL != "" || title != "" {
+		maxText = TemplateTextWithHeaderMaxLength
+	}
+	if UTF16Length(text) > maxText {
+		return invalidContent(fieldPath(path, "text"), fmt.Sprintf("must be at most %d characters", maxText))
+	}
+	return nil
+}
+
+func validateTemplateActions(path string, actions []Action, minCount, maxCount int) error {
+	if len(actions) < minCount || len(actions) > maxCount {
+		if minCount == maxCount {
+			return invalidContent(path, fmt.Sprintf("must contain exactly %d actions", minCount))
+		}
+		return invalidContent(path, fmt.Sprintf("must contain %d to %d actions", minCount, maxCount))
+	}
+	for i := range actions {
+		actionPath := fmt.Sprintf("%s[%d]", path, i)
+		if actions[i].IsQuickReplyOnly() {
+			return invalidContent(actionPath+".type", fmt.Sprintf("%q is only available in quick replies", actions[i].Type))
+		}
+		if err := actions[i].Validate(actionPath, ActionLabelMaxLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDefaultAction 画像等のタップ時アクション（ラベルは任意）
+func validateDefaultAction(path string, action *Action) error {
+	if action == nil {
+		return nil
+	}
+	if action.IsQuickReplyOnly() {
+		return invalidContent(path+".type", fmt.Sprintf("%q is only available in quick replies", action.Type))
+	}
+	if UTF16Length(action.Label) > ActionLabelMaxLength {
+		return invalidContent(path+".label", fmt.Sprintf("must be at most %d characters", ActionLabelMaxLength))
+	}
+	return action.validatePayload(path)
+}
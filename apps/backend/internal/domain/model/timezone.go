@@ -0,0 +1,79 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	// 実行環境にタイムゾーンDBがなくても IANA タイムゾーンを解決できるよう埋め込む
+	_ "time/tzdata"
+)
+
+var (
+	// ErrInvalidTimezone IANA タイムゾーン名として解決できない
+	ErrInvalidTimezone = errors.New("invalid timezone")
+	// ErrInvalidLocalTime ローカル日時の形式が不正、または夏時間の切り替えで存在しない
+	ErrInvalidLocalTime = errors.New("invalid local time")
+)
+
+// localTimeLayouts APIで受け付けるローカル日時（オフセットなし）の形式
+var localTimeLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02 15:04"}
+
+// LoadTimezone IANA タイムゾーン名（例: Asia/Tokyo）を解決
+func LoadTimezone(name string) (*time.Location, error) {
+	// time.LoadLocation は "" と "Local" をサーバーのローカル時刻として扱うため除外する
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, name)
+	}
+	return loc, nil
+}
+
+// ParseLocalTime タイムゾーンにおけるローカル日時を解析する。
+// 夏時間の開始で存在しない時刻はエラー、終了で2回現れる時刻は早い方を採用する
+func ParseLocalTime(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range localTimeLayouts {
+		wall, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		t := LocalTime(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), loc)
+		if !sameWallClock(t, wall) {
+			return time.Time{}, fmt.Errorf("%w: %s does not exist in %s", ErrInvalidLocalTime, value, loc)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%w: %q must be formatted as YYYY-MM-DDTHH:MM[:SS]", ErrInvalidLocalTime, value)
+}
+
+// LocalTime 壁時計の日時を loc の時刻に変換する。time.Date と異なり夏時間の境界でも結果が決まる。
+// 2回現れる時刻（夏時間の終了）は早い方、存在しない時刻（夏時間の開始）は切り替え幅だけ後ろにずらす
+func LocalTime(year int, month time.Month, day, hour, min, sec int, loc *time.Location) time.Time {
+	wall := time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+	t := time.Date(year, month, day, hour, min, sec, 0, loc)
+
+	// 前後のオフセットそれぞれで解釈し直す
+	var matched, latest time.Time
+	for _, probe := range []time.Time{t.Add(-12 * time.Hour), t, t.Add(12 * time.Hour)} {
+		_, offset := probe.Zone()
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(candidate, wall) && (matched.IsZero() || candidate.Before(matched)) {
+			matched = candidate
+		}
+		if candidate.After(latest) {
+			latest = candidate
+		}
+	}
+	if !matched.IsZero() {
+		return matched
+	}
+	return latest
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := wall.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 && t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second()
+}
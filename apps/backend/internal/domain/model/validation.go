@@ -0,0 +1,144 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// FieldError 検証に失敗したフィールドと理由（errors.Is(err, ErrInvalidContent) で判定可能）
+type FieldError struct {
+	// Field JSON上のパス（例: parts[1].text.text）。メッセージ全体に関するエラーの場合は空
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s: %s", ErrInvalidContent, e.Message)
+	}
+	return fmt.Sprintf("%s: %s %s", ErrInvalidContent, e.Field, e.Message)
+}
+
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidContent
+}
+
+// ValidationError 複数フィールドの検証エラーをまとめたもの（errors.Is(err, ErrInvalidContent) で判定可能）
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	reasons := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		reasons[i] = strings.TrimPrefix(fieldErr.Error(), ErrInvalidContent.Error()+": ")
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidContent, strings.Join(reasons, "; "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidContent
+}
+
+// FieldErrors エラーに含まれるフィールド単位のエラーを返す（検証エラーでない場合は nil）
+func FieldErrors(err error) []*FieldError {
+	switch e := err.(type) {
+	case *ValidationError:
+		return e.Errors
+	case *FieldError:
+		return []*FieldError{e}
+	default:
+		return nil
+	}
+}
+
+// UTF16Length LINEと同じ数え方（UTF-16のコードユニット数）で文字数を数える。
+// 絵文字などサロゲートペアになる文字は2文字として数える
+func UTF16Length(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
+
+func invalidContent(field, reason string) error {
+	return &FieldError{Field: field, Message: reason}
+}
+
+// validationErrors 検証エラーをまとめる（エラーがなければ nil）
+func validationErrors(errs []*FieldError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: errs}
+}
+
+// prefixField 子要素の検証エラーのフィールドパスに親のパスを付ける
+func prefixField(prefix string, err error) error {
+	if err == nil {
+		return nil
+	}
+	fieldErrs := FieldErrors(err)
+	if fieldErrs == nil {
+		return err
+	}
+	prefixed := make([]*FieldError, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		prefixed[i] = &FieldError{Field: fieldPath(prefix, fieldErr.Field), Message: fieldErr.Message}
+	}
+	if len(prefixed) == 1 {
+		if _, ok := err.(*FieldError); ok {
+			return prefixed[0]
+		}
+	}
+	return &ValidationError{Errors: prefixed}
+}
+
+func fieldPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
+
+// Validate 送信前にLINEの制限値に照らしてメッセージ全体を検証し、すべてのフィールドエラーを返す
+func (m *Message) Validate() error {
+	var errs []*FieldError
+
+	if len(m.Contents()) == 0 {
+		// リッチコンテンツがない場合はタイトルと本文を1つのテキストとして送信する
+		if UTF16Length(m.Title)+UTF16Length(m.Body)+2 > TextMaxLength {
+			errs = append(errs, &FieldError{
+				Field:   "body",
+				Message: fmt.Sprintf("must be at most %d characters including the title", TextMaxLength),
+			})
+		}
+	}
+	if m.Content != nil {
+		errs = append(errs, FieldErrors(prefixField("content", m.Content.Validate()))...)
+	}
+	errs = append(errs, FieldErrors(m.Parts.Validate())...)
+	errs = append(errs, FieldErrors(m.QuickReply.Validate())...)
+	errs = append(errs, FieldErrors(m.Recipients.Validate())...)
+	if m.Target != DeliveryTargetRecipients && m.Target != "" && len(m.Recipients) > 0 {
+		errs = append(errs, &FieldError{Field: "recipients", Message: fmt.Sprintf("must be empty for %s messages", m.Target)})
+	}
+	switch {
+	case m.Target == DeliveryTargetNarrowcast && m.Narrowcast == nil:
+		errs = append(errs, &FieldError{Field: "narrowcast", Message: "is required for narrowcast messages"})
+	case m.Target == DeliveryTargetNarrowcast:
+		errs = append(errs, FieldErrors(prefixField("narrowcast", m.Narrowcast.Validate()))...)
+	case m.Narrowcast != nil:
+		errs = append(errs, &FieldError{Field: "narrowcast", Message: "must be empty unless target is narrowcast"})
+	}
+
+	return validationErrors(errs)
+}
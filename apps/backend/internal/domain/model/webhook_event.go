@@ -0,0 +1,215 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidSignature Webhookの署名が一致しない（errors.Is で判定可能）
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrWebhookNotConfigured 署名の検証に使うチャネルシークレットが未設定
+	ErrWebhookNotConfigured = errors.New("webhook channel secret is not configured")
+)
+
+// WebhookEventType LINEから通知されるイベントの種別
+type WebhookEventType string
+
+const (
+	WebhookEventMessage           WebhookEventType = "message"
+	WebhookEventUnsend            WebhookEventType = "unsend"
+	WebhookEventFollow            WebhookEventType = "follow"
+	WebhookEventUnfollow          WebhookEventType = "unfollow"
+	WebhookEventJoin              WebhookEventType = "join"
+	WebhookEventLeave             WebhookEventType = "leave"
+	WebhookEventMemberJoined      WebhookEventType = "memberJoined"
+	WebhookEventMemberLeft        WebhookEventType = "memberLeft"
+	WebhookEventPostback          WebhookEventType = "postback"
+	WebhookEventVideoPlayComplete WebhookEventType = "videoPlayComplete"
+	WebhookEventBeacon            WebhookEventType = "beacon"
+	WebhookEventAccountLink       WebhookEventType = "accountLink"
+	WebhookEventThings            WebhookEventType = "things"
+	WebhookEventMembership        WebhookEventType = "membership"
+	WebhookEventModule            WebhookEventType = "module"
+	WebhookEventActivated         WebhookEventType = "activated"
+	WebhookEventDeactivated       WebhookEventType = "deactivated"
+	WebhookEventBotSuspended      WebhookEventType = "botSuspended"
+	WebhookEventBotResumed        WebhookEventType = "botResumed"
+)
+
+// WebhookSourceType イベントの送信元の種別
+type WebhookSourceType string
+
+const (
+	WebhookSourceUser  WebhookSourceType = "user"
+	WebhookSourceGroup WebhookSourceType = "group"
+	WebhookSourceRoom  WebhookSourceType = "room"
+)
+
+// WebhookPayload Webhookのリクエスト1件分（イベントは複数まとめて届く）
+type WebhookPayload struct {
+	// Destination イベントを受け取ったボットのユーザーID
+	Destination string          `json:"destination"`
+	Events      []*WebhookEvent `json:"events"`
+}
+
+// WebhookEvent LINEから通知されたイベント。Type に対応するフィールドのみ設定される
+type WebhookEvent struct {
+	Type WebhookEventType `json:"type"`
+	// Mode チャネルの状態（active または standby）
+	Mode           string         `json:"mode"`
+	Timestamp      time.Time      `json:"timestamp"`
+	Source         *WebhookSource `json:"source,omitempty"`
+	WebhookEventID string         `json:"webhook_event_id"`
+	IsRedelivery   bool           `json:"is_redelivery"`
+	// ReplyToken 応答メッセージの送信に使うトークン（応答できるイベントのみ）
+	ReplyToken string `json:"reply_token,omitempty"`
+
+	Message           *WebhookMessage           `json:"message,omitempty"`
+	Unsend            *WebhookUnsend            `json:"unsend,omitempty"`
+	Follow            *WebhookFollow            `json:"follow,omitempty"`
+	Joined            *WebhookMembers           `json:"joined,omitempty"`
+	Left              *WebhookMembers           `json:"left,omitempty"`
+	Postback          *WebhookPostback          `json:"postback,omitempty"`
+	VideoPlayComplete *WebhookVideoPlayComplete `json:"video_play_complete,omitempty"`
+	Beacon            *WebhookBeacon            `json:"beacon,omitempty"`
+	AccountLink       *WebhookAccountLink       `json:"account_link,omitempty"`
+	Things            *WebhookThings            `json:"things,omitempty"`
+	Membership        *WebhookMembership        `json:"membership,omitempty"`
+	Module            *WebhookModule            `json:"module,omitempty"`
+	ChatControl       *WebhookChatControl       `json:"chat_control,omitempty"`
+}
+
+// UserID 送信元のユーザーID（グループ・トークルームで取得できない場合は空）
+func (e *WebhookEvent) UserID() string {
+	if e.Source == nil {
+		return ""
+	}
+	return e.Source.UserID
+}
+
+// WebhookSource イベントの送信元（ユーザー・グループ・トークルーム）
+type WebhookSource struct {
+	Type    WebhookSourceType `json:"type"`
+	UserID  string            `json:"user_id,omitempty"`
+	GroupID string            `json:"group_id,omitempty"`
+	RoomID  string            `json:"room_id,omitempty"`
+}
+
+// WebhookMessageType 受信したメッセージの種別
+type WebhookMessageType string
+
+const (
+	WebhookMessageText     WebhookMessageType = "text"
+	WebhookMessageImage    WebhookMessageType = "image"
+	WebhookMessageVideo    WebhookMessageType = "video"
+	WebhookMessageAudio    WebhookMessageType = "audio"
+	WebhookMessageFile     WebhookMessageType = "file"
+	WebhookMessageLocation WebhookMessageType = "location"
+	WebhookMessageSticker  WebhookMessageType = "sticker"
+)
+
+// WebhookMessage 受信したメッセージ。Type に応じて使われるフィールドが異なる
+type WebhookMessage struct {
+	ID   string             `json:"id"`
+	Type WebhookMessageType `json:"type"`
+	// QuoteToken このメッセージを引用して送信する場合に使うトークン
+	QuoteToken      string             `json:"quote_token,omitempty"`
+	QuotedMessageID string             `json:"quoted_message_id,omitempty"`
+	Text            string             `json:"text,omitempty"`
+	Emojis          []TextEmoji        `json:"emojis,omitempty"`
+	Mentionees      []WebhookMentionee `json:"mentionees,omitempty"`
+	// ContentProvider 画像・動画・音声の提供元（line の場合はコンテンツ取得APIで取得する）
+	ContentProvider *WebhookContentProvider `json:"content_provider,omitempty"`
+	// Duration 動画・音声の長さ（ミリ秒）
+	Duration  int64   `json:"duration,omitempty"`
+	FileName  string  `json:"file_name,omitempty"`
+	FileSize  int64   `json:"file_size,omitempty"`
+	Title     string  `json:"title,omitempty"`
+	Address   string  `json:"address,omitempty"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+	PackageID string  `json:"package_id,omitempty"`
+	StickerID string  `json:"sticker_id,omitempty"`
+	// StickerResourceType スタンプの種類（STATIC、ANIMATION など）
+	StickerResourceType string   `json:"sticker_resource_type,omitempty"`
+	Keywords            []string `json:"keywords,omitempty"`
+}
+
+// WebhookMentionee テキスト中のメンション
+type WebhookMentionee struct {
+	// Index メンションの開始位置（UTF-16のコードユニット単位）
+	Index  int    `json:"index"`
+	Length int    `json:"length"`
+	Type   string `json:"type"`
+	UserID string `json:"user_id,omitempty"`
+	IsSelf bool   `json:"is_self,omitempty"`
+}
+
+type WebhookContentProvider struct {
+	Type               string `json:"type"`
+	OriginalContentURL string `json:"original_content_url,omitempty"`
+	PreviewImageURL    string `json:"preview_image_url,omitempty"`
+}
+
+// WebhookUnsend 送信取消されたメッセージ
+type WebhookUnsend struct {
+	MessageID string `json:"message_id"`
+}
+
+// WebhookFollow 友だち追加（ブロック解除の場合は IsUnblocked が true）
+type WebhookFollow struct {
+	IsUnblocked bool `json:"is_unblocked"`
+}
+
+// WebhookMembers グループ・トークルームに参加または退出したメンバー
+type WebhookMembers struct {
+	Members []WebhookSource `json:"members"`
+}
+
+type WebhookPostback struct {
+	Data string `json:"data"`
+	// Params 日時選択アクションの選択値やリッチメニュー切替の結果
+	Params map[string]string `json:"params,omitempty"`
+}
+
+type WebhookVideoPlayComplete struct {
+	TrackingID string `json:"tracking_id"`
+}
+
+type WebhookBeacon struct {
+	HWID          string `json:"hwid"`
+	Type          string `json:"type"`
+	DeviceMessage string `json:"device_message,omitempty"`
+}
+
+type WebhookAccountLink struct {
+	Result string `json:"result"`
+	Nonce  string `json:"nonce,omitempty"`
+}
+
+// WebhookThings LINE Thingsのデバイス連携・シナリオ実行の結果（Result は受け取ったまま保持）
+type WebhookThings struct {
+	DeviceID string          `json:"device_id"`
+	Type     string          `json:"type"`
+	Result   json.RawMessage `json:"result,omitempty"`
+}
+
+type WebhookMembership struct {
+	// Type joined、left、renewed のいずれか
+	Type         string `json:"type"`
+	MembershipID int64  `json:"membership_id"`
+}
+
+// WebhookModule モジュールチャネルのアタッチ・デタッチ
+type WebhookModule struct {
+	Type   string   `json:"type"`
+	BotID  string   `json:"bot_id,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// WebhookChatControl チャットの主導権の取得期限（activated のみ）
+type WebhookChatControl struct {
+	ExpireAt time.Time `json:"expire_at"`
+}
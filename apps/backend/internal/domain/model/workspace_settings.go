@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// DefaultWorkspaceTimezone ワークスペース設定が未保存の場合の既定タイムゾーン
+const DefaultWorkspaceTimezone = "Asia/Tokyo"
+
+// WorkspaceSettings ワークスペース（このLINE公式アカウントの運用）全体の設定
+type WorkspaceSettings struct {
+	// DefaultTimezone 予約・繰り返し配信でタイムゾーン未指定時に使用する IANA タイムゾーン
+	DefaultTimezone string    `json:"default_timezone" db:"default_timezone"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SetDefaultTimezone 既定タイムゾーンを変更（IANA タイムゾーン名を検証）
+func (s *WorkspaceSettings) SetDefaultTimezone(timezone string) error {
+	if _, err := LoadTimezone(timezone); err != nil {
+		return err
+	}
+	s.DefaultTimezone = timezone
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// NewWorkspaceSettings 既定値の設定を作成
+func NewWorkspaceSettings() *WorkspaceSettings {
+	return &WorkspaceSettings{
+		DefaultTimezone: DefaultWorkspaceTimezone,
+		UpdatedAt:       time.Now(),
+	}
+}
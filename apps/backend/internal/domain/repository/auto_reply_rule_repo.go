@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type AutoReplyRuleRepository interface {
+	// Create 新しい自動応答ルールを作成
+	Create(ctx context.Context, rule *model.AutoReplyRule) error
+
+	// FindByID IDで自動応答ルールを取得
+	FindByID(ctx context.Context, id uuid.UUID) (*model.AutoReplyRule, error)
+
+	// List 自動応答ルール一覧を照合順（優先度の高い順、同じ場合は作成日時の古い順）で取得（ページング対応）
+	List(ctx context.Context, limit, offset int) ([]*model.AutoReplyRule, error)
+
+	// ListEnabled 有効な自動応答ルールを全て取得（照合順）
+	ListEnabled(ctx context.Context) ([]*model.AutoReplyRule, error)
+
+	// Update 自動応答ルールを更新
+	Update(ctx context.Context, rule *model.AutoReplyRule) error
+
+	// Delete 自動応答ルールを削除
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type DeliveryBatchRepository interface {
+	// CreateBatches メッセージの送信バッチをまとめて作成
+	CreateBatches(ctx context.Context, batches []*model.DeliveryBatch) error
+
+	// ListByMessage メッセージの送信バッチを番号順に取得
+	ListByMessage(ctx context.Context, messageID uuid.UUID) ([]*model.DeliveryBatch, error)
+
+	// FindAccepted LINEが受け付けて送信が完了していないバッチを、進捗の確認が古い順に取得
+	FindAccepted(ctx context.Context, limit int) ([]*model.DeliveryBatch, error)
+
+	// Update 送信結果を更新
+	Update(ctx context.Context, batch *model.DeliveryBatch) error
+
+	// SumAudience since 以降に送信に成功した宛先数を送信対象別に集計
+	SumAudience(ctx context.Context, since time.Time) (map[model.DeliveryTarget]int, error)
+}
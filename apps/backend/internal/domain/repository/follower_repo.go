@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type FollowerRepository interface {
+	// Save 友だちを保存（同じLINEユーザーIDが登録済みの場合は更新）
+	Save(ctx context.Context, follower *model.Follower) error
+
+	// FindByID IDで友だちを取得
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Follower, error)
+
+	// FindByLineUserID LINEユーザーIDで友だちを取得
+	FindByLineUserID(ctx context.Context, lineUserID string) (*model.Follower, error)
+
+	// List 絞り込んだ友だち一覧を取得（友だち追加の新しい順、ページング対応）
+	List(ctx context.Context, filter *model.FollowerFilter, limit, offset int) ([]*model.Follower, error)
+
+	// Count 絞り込み条件に一致する友だちの件数を取得
+	Count(ctx context.Context, filter *model.FollowerFilter) (int, error)
+}
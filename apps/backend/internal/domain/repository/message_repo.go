@@ -12,17 +12,38 @@ type MessageRepository interface {
 	// Create 新しいメッセージを作成
 	Create(ctx context.Context, message *model.Message) error
 
-	// FindByID IDでメッセージを取得
+	// FindByID IDでメッセージを取得（FindByID / List / Search はタグも読み込む）
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Message, error)
 
-	// List メッセージ一覧を取得（ページング対応）
-	List(ctx context.Context, limit, offset int) ([]*model.Message, error)
+	// FindByIDForUpdate IDでメッセージを行ロック付きで取得（トランザクション内で使う。コミットまで他の更新を待たせる）
+	FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Message, error)
+
+	// List 条件に一致するメッセージを並び替えて取得（カーソルまたはオフセットでページング）
+	List(ctx context.Context, query *model.MessageQuery) ([]*model.Message, error)
+
+	// Count 条件に一致するメッセージの件数を取得
+	Count(ctx context.Context, filter *model.MessageFilter) (int, error)
+
+	// Search タイトル・本文に全ての検索語を含むメッセージを関連度の高い順に取得（ハイライトは未設定）
+	Search(ctx context.Context, terms []string, limit, offset int) ([]*model.MessageSearchResult, error)
 
 	// Update メッセージを更新
 	Update(ctx context.Context, message *model.Message) error
 
+	// SetTags メッセージのタグ割り当てを指定したタグで置き換える
+	SetTags(ctx context.Context, messageID uuid.UUID, tagIDs []uuid.UUID) error
+
 	// FindScheduledMessages スケジュール済みメッセージを取得
 	FindScheduledMessages(ctx context.Context, until time.Time, limit int) ([]*model.Message, error)
+
+	// FindStuckSending before 以前から送信中のままのメッセージを取得（LINEが受け付け済みの絞り込み配信は除く）
+	FindStuckSending(ctx context.Context, before time.Time, limit int) ([]*model.Message, error)
+
+	// ListRevisions リビジョン一覧を取得（新しい順）。リビジョンは Create / Update のたびに記録される
+	ListRevisions(ctx context.Context, messageID uuid.UUID) ([]*model.MessageRevision, error)
+
+	// FindRevision リビジョン番号で取得
+	FindRevision(ctx context.Context, messageID uuid.UUID, revision int) (*model.MessageRevision, error)
 }
 
 type TxManager interface {
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type MessageTemplateRepository interface {
+	// Create 新しいテンプレートを作成
+	Create(ctx context.Context, template *model.MessageTemplate) error
+
+	// FindByID IDでテンプレートを取得
+	FindByID(ctx context.Context, id uuid.UUID) (*model.MessageTemplate, error)
+
+	// List テンプレート一覧を取得（ページング対応）
+	List(ctx context.Context, limit, offset int) ([]*model.MessageTemplate, error)
+
+	// Update テンプレートを更新
+	Update(ctx context.Context, template *model.MessageTemplate) error
+
+	// Delete テンプレートを削除
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -4,10 +4,11 @@ package mocks
 
 import (
 	context "context"
-	model "vt-link/backend/internal/domain/model"
 
 	mock "github.com/stretchr/testify/mock"
 
+	model "vt-link/backend/internal/domain/model"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -26,6 +27,63 @@ func (_m *MockMessageRepository) EXPECT() *MockMessageRepository_Expecter {
 	return &MockMessageRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function with given fields: ctx, filter
+func (_m *MockMessageRepository) Count(ctx context.Context, filter *model.MessageFilter) (int, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MessageFilter) (int, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MessageFilter) int); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MessageFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockMessageRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.MessageFilter
+func (_e *MockMessageRepository_Expecter) Count(ctx interface{}, filter interface{}) *MockMessageRepository_Count_Call {
+	return &MockMessageRepository_Count_Call{Call: _e.mock.On("Count", ctx, filter)}
+}
+
+func (_c *MockMessageRepository_Count_Call) Run(run func(ctx context.Context, filter *model.MessageFilter)) *MockMessageRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.MessageFilter))
+	})
+	return _c
+}
+
+func (_c *MockMessageRepository_Count_Call) Return(_a0 int, _a1 error) *MockMessageRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMessageRepository_Count_Call) RunAndReturn(run func(context.Context, *model.MessageFilter) (int, error)) *MockMessageRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, message
 func (_m *MockMessageRepository) Create(ctx context.Context, message *model.Message) error {
 	ret := _m.Called(ctx, message)
@@ -132,6 +190,125 @@ func (_c *MockMessageRepository_FindByID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// FindByIDForUpdate provides a mock function with given fields: ctx, id
+func (_m *MockMessageRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDForUpdate")
+	}
+
+	var r0 *model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Message, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Message); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageRepository_FindByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDForUpdate'
+type MockMessageRepository_FindByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// FindByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockMessageRepository_Expecter) FindByIDForUpdate(ctx interface{}, id interface{}) *MockMessageRepository_FindByIDForUpdate_Call {
+	return &MockMessageRepository_FindByIDForUpdate_Call{Call: _e.mock.On("FindByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockMessageRepository_FindByIDForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockMessageRepository_FindByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMessageRepository_FindByIDForUpdate_Call) Return(_a0 *model.Message, _a1 error) *MockMessageRepository_FindByIDForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMessageRepository_FindByIDForUpdate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Message, error)) *MockMessageRepository_FindByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRevision provides a mock function with given fields: ctx, messageID, revision
+func (_m *MockMessageRepository) FindRevision(ctx context.Context, messageID uuid.UUID, revision int) (*model.MessageRevision, error) {
+	ret := _m.Called(ctx, messageID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRevision")
+	}
+
+	var r0 *model.MessageRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*model.MessageRevision, error)); ok {
+		return rf(ctx, messageID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *model.MessageRevision); ok {
+		r0 = rf(ctx, messageID, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MessageRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, messageID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageRepository_FindRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRevision'
+type MockMessageRepository_FindRevision_Call struct {
+	*mock.Call
+}
+
+// FindRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uuid.UUID
+//   - revision int
+func (_e *MockMessageRepository_Expecter) FindRevision(ctx interface{}, messageID interface{}, revision interface{}) *MockMessageRepository_FindRevision_Call {
+	return &MockMessageRepository_FindRevision_Call{Call: _e.mock.On("FindRevision", ctx, messageID, revision)}
+}
+
+func (_c *MockMessageRepository_FindRevision_Call) Run(run func(ctx context.Context, messageID uuid.UUID, revision int)) *MockMessageRepository_FindRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMessageRepository_FindRevision_Call) Return(_a0 *model.MessageRevision, _a1 error) *MockMessageRepository_FindRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMessageRepository_FindRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (*model.MessageRevision, error)) *MockMessageRepository_FindRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindScheduledMessages provides a mock function with given fields: ctx, until, limit
 func (_m *MockMessageRepository) FindScheduledMessages(ctx context.Context, until time.Time, limit int) ([]*model.Message, error) {
 	ret := _m.Called(ctx, until, limit)
@@ -192,9 +369,69 @@ func (_c *MockMessageRepository_FindScheduledMessages_Call) RunAndReturn(run fun
 	return _c
 }
 
-// List provides a mock function with given fields: ctx, limit, offset
-func (_m *MockMessageRepository) List(ctx context.Context, limit int, offset int) ([]*model.Message, error) {
-	ret := _m.Called(ctx, limit, offset)
+// FindStuckSending provides a mock function with given fields: ctx, before, limit
+func (_m *MockMessageRepository) FindStuckSending(ctx context.Context, before time.Time, limit int) ([]*model.Message, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStuckSending")
+	}
+
+	var r0 []*model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*model.Message, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*model.Message); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageRepository_FindStuckSending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStuckSending'
+type MockMessageRepository_FindStuckSending_Call struct {
+	*mock.Call
+}
+
+// FindStuckSending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockMessageRepository_Expecter) FindStuckSending(ctx interface{}, before interface{}, limit interface{}) *MockMessageRepository_FindStuckSending_Call {
+	return &MockMessageRepository_FindStuckSending_Call{Call: _e.mock.On("FindStuckSending", ctx, before, limit)}
+}
+
+func (_c *MockMessageRepository_FindStuckSending_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockMessageRepository_FindStuckSending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMessageRepository_FindStuckSending_Call) Return(_a0 []*model.Message, _a1 error) *MockMessageRepository_FindStuckSending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMessageRepository_FindStuckSending_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*model.Message, error)) *MockMessageRepository_FindStuckSending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, query
+func (_m *MockMessageRepository) List(ctx context.Context, query *model.MessageQuery) ([]*model.Message, error) {
+	ret := _m.Called(ctx, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
@@ -202,19 +439,19 @@ func (_m *MockMessageRepository) List(ctx context.Context, limit int, offset int
 
 	var r0 []*model.Message
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*model.Message, error)); ok {
-		return rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MessageQuery) ([]*model.Message, error)); ok {
+		return rf(ctx, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Message); ok {
-		r0 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MessageQuery) []*model.Message); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Message)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
-		r1 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MessageQuery) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -229,25 +466,192 @@ type MockMessageRepository_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
+//   - query *model.MessageQuery
+func (_e *MockMessageRepository_Expecter) List(ctx interface{}, query interface{}) *MockMessageRepository_List_Call {
+	return &MockMessageRepository_List_Call{Call: _e.mock.On("List", ctx, query)}
+}
+
+func (_c *MockMessageRepository_List_Call) Run(run func(ctx context.Context, query *model.MessageQuery)) *MockMessageRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.MessageQuery))
+	})
+	return _c
+}
+
+func (_c *MockMessageRepository_List_Call) Return(_a0 []*model.Message, _a1 error) *MockMessageRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMessageRepository_List_Call) RunAndReturn(run func(context.Context, *model.MessageQuery) ([]*model.Message, error)) *MockMessageRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRevisions provides a mock function with given fields: ctx, messageID
+func (_m *MockMessageRepository) ListRevisions(ctx context.Context, messageID uuid.UUID) ([]*model.MessageRevision, error) {
+	ret := _m.Called(ctx, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []*model.MessageRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*model.MessageRevision, error)); ok {
+		return rf(ctx, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.MessageRevision); ok {
+		r0 = rf(ctx, messageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MessageRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageRepository_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type MockMessageRepository_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uuid.UUID
+func (_e *MockMessageRepository_Expecter) ListRevisions(ctx interface{}, messageID interface{}) *MockMessageRepository_ListRevisions_Call {
+	return &MockMessageRepository_ListRevisions_Call{Call: _e.mock.On("ListRevisions", ctx, messageID)}
+}
+
+func (_c *MockMessageRepository_ListRevisions_Call) Run(run func(ctx context.Context, messageID uuid.UUID)) *MockMessageRepository_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMessageRepository_ListRevisions_Call) Return(_a0 []*model.MessageRevision, _a1 error) *MockMessageRepository_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMessageRepository_ListRevisions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*model.MessageRevision, error)) *MockMessageRepository_ListRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, terms, limit, offset
+func (_m *MockMessageRepository) Search(ctx context.Context, terms []string, limit int, offset int) ([]*model.MessageSearchResult, error) {
+	ret := _m.Called(ctx, terms, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*model.MessageSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, int) ([]*model.MessageSearchResult, error)); ok {
+		return rf(ctx, terms, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, int) []*model.MessageSearchResult); ok {
+		r0 = rf(ctx, terms, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MessageSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int, int) error); ok {
+		r1 = rf(ctx, terms, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockMessageRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - terms []string
 //   - limit int
 //   - offset int
-func (_e *MockMessageRepository_Expecter) List(ctx interface{}, limit interface{}, offset interface{}) *MockMessageRepository_List_Call {
-	return &MockMessageRepository_List_Call{Call: _e.mock.On("List", ctx, limit, offset)}
+func (_e *MockMessageRepository_Expecter) Search(ctx interface{}, terms interface{}, limit interface{}, offset interface{}) *MockMessageRepository_Search_Call {
+	return &MockMessageRepository_Search_Call{Call: _e.mock.On("Search", ctx, terms, limit, offset)}
 }
 
-func (_c *MockMessageRepository_List_Call) Run(run func(ctx context.Context, limit int, offset int)) *MockMessageRepository_List_Call {
+func (_c *MockMessageRepository_Search_Call) Run(run func(ctx context.Context, terms []string, limit int, offset int)) *MockMessageRepository_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(int))
+		run(args[0].(context.Context), args[1].([]string), args[2].(int), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *MockMessageRepository_List_Call) Return(_a0 []*model.Message, _a1 error) *MockMessageRepository_List_Call {
+func (_c *MockMessageRepository_Search_Call) Return(_a0 []*model.MessageSearchResult, _a1 error) *MockMessageRepository_Search_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMessageRepository_List_Call) RunAndReturn(run func(context.Context, int, int) ([]*model.Message, error)) *MockMessageRepository_List_Call {
+func (_c *MockMessageRepository_Search_Call) RunAndReturn(run func(context.Context, []string, int, int) ([]*model.MessageSearchResult, error)) *MockMessageRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTags provides a mock function with given fields: ctx, messageID, tagIDs
+func (_m *MockMessageRepository) SetTags(ctx context.Context, messageID uuid.UUID, tagIDs []uuid.UUID) error {
+	ret := _m.Called(ctx, messageID, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r0 = rf(ctx, messageID, tagIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMessageRepository_SetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTags'
+type MockMessageRepository_SetTags_Call struct {
+	*mock.Call
+}
+
+// SetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uuid.UUID
+//   - tagIDs []uuid.UUID
+func (_e *MockMessageRepository_Expecter) SetTags(ctx interface{}, messageID interface{}, tagIDs interface{}) *MockMessageRepository_SetTags_Call {
+	return &MockMessageRepository_SetTags_Call{Call: _e.mock.On("SetTags", ctx, messageID, tagIDs)}
+}
+
+func (_c *MockMessageRepository_SetTags_Call) Run(run func(ctx context.Context, messageID uuid.UUID, tagIDs []uuid.UUID)) *MockMessageRepository_SetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMessageRepository_SetTags_Call) Return(_a0 error) *MockMessageRepository_SetTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMessageRepository_SetTags_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) error) *MockMessageRepository_SetTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
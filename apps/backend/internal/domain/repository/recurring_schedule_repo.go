@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type RecurringScheduleRepository interface {
+	// Create 新しい繰り返し配信を作成
+	Create(ctx context.Context, schedule *model.RecurringSchedule) error
+
+	// FindByID IDで繰り返し配信を取得
+	FindByID(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error)
+
+	// List 繰り返し配信一覧を取得（ページング対応）
+	List(ctx context.Context, limit, offset int) ([]*model.RecurringSchedule, error)
+
+	// Update 繰り返し配信を更新
+	Update(ctx context.Context, schedule *model.RecurringSchedule) error
+
+	// AdvanceIfDue 有効なまま次回日時が nextRunAt の場合だけ次回・前回の日時を更新し、更新したかどうかを返す
+	// （同時に実行されたスケジューラが先に進めた場合や、その間に停止された場合は false）
+	AdvanceIfDue(ctx context.Context, schedule *model.RecurringSchedule, nextRunAt time.Time) (bool, error)
+
+	// PauseIfDue 有効なまま次回日時が nextRunAt の場合だけ停止し、停止したかどうかを返す
+	PauseIfDue(ctx context.Context, schedule *model.RecurringSchedule, nextRunAt time.Time) (bool, error)
+
+	// Delete 繰り返し配信を削除
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// FindDueSchedules 次回日時を過ぎた有効な繰り返し配信を取得
+	FindDueSchedules(ctx context.Context, until time.Time, limit int) ([]*model.RecurringSchedule, error)
+}
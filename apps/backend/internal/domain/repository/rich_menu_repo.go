@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type RichMenuRepository interface {
+	// Create 新しいリッチメニューを作成
+	Create(ctx context.Context, menu *model.RichMenu) error
+
+	// FindByID IDでリッチメニューを取得（画像も読み込む）
+	FindByID(ctx context.Context, id uuid.UUID) (*model.RichMenu, error)
+
+	// List リッチメニュー一覧を作成日時の新しい順で取得（画像は読み込まない）
+	List(ctx context.Context, limit, offset int) ([]*model.RichMenu, error)
+
+	// Update リッチメニューを更新（Create / Update で未記録の Revision の定義はリビジョンとして記録される）
+	Update(ctx context.Context, menu *model.RichMenu) error
+
+	// SetDefault 指定したリッチメニューを既定にし、他のリッチメニューの既定を外す
+	SetDefault(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// Delete リッチメニューを削除（ユーザーへのリンクも削除される）
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListRevisions 定義のリビジョン一覧を取得（新しい順）
+	ListRevisions(ctx context.Context, richMenuID uuid.UUID) ([]*model.RichMenuRevision, error)
+
+	// LinkUsers ユーザーにリッチメニューをリンクしたことを記録（既に別のリッチメニューにリンクしている場合は置き換える）
+	LinkUsers(ctx context.Context, richMenuID uuid.UUID, userIDs []string, at time.Time) error
+
+	// UnlinkUsers ユーザーのリンクの記録を削除
+	UnlinkUsers(ctx context.Context, userIDs []string) error
+
+	// ListLinkedUserIDs リッチメニューにリンクしているユーザーのLINEユーザーIDを取得
+	ListLinkedUserIDs(ctx context.Context, richMenuID uuid.UUID) ([]string, error)
+}
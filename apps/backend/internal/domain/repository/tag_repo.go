@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+)
+
+type TagRepository interface {
+	// Create 新しいタグを作成
+	Create(ctx context.Context, tag *model.Tag) error
+
+	// FindByID IDでタグを取得
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Tag, error)
+
+	// FindByName 名前でタグを取得（大文字小文字は区別しない）
+	FindByName(ctx context.Context, name string) (*model.Tag, error)
+
+	// FindByIDs 複数のIDでタグを取得（存在しないIDは結果に含まれない）
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Tag, error)
+
+	// List タグ一覧を使用数付きで取得（ページング対応）
+	List(ctx context.Context, limit, offset int) ([]*model.Tag, error)
+
+	// Update タグを更新
+	Update(ctx context.Context, tag *model.Tag) error
+
+	// Delete タグを削除（メッセージへの割り当ても外れる）
+	Delete(ctx context.Context, id uuid.UUID) error
+}
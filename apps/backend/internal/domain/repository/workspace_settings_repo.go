@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type WorkspaceSettingsRepository interface {
+	// Get ワークスペース設定を取得（未保存の場合は既定値）
+	Get(ctx context.Context) (*model.WorkspaceSettings, error)
+
+	// Save ワークスペース設定を保存
+	Save(ctx context.Context, settings *model.WorkspaceSettings) error
+}
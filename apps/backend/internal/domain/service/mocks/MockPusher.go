@@ -6,6 +6,10 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	model "vt-link/backend/internal/domain/model"
+
+	uuid "github.com/google/uuid"
 )
 
 // MockPusher is an autogenerated mock type for the Pusher type
@@ -21,17 +25,365 @@ func (_m *MockPusher) EXPECT() *MockPusher_Expecter {
 	return &MockPusher_Expecter{mock: &_m.Mock}
 }
 
-// PushMessage provides a mock function with given fields: ctx, title, body
-func (_m *MockPusher) PushMessage(ctx context.Context, title string, body string) error {
-	ret := _m.Called(ctx, title, body)
+// Broadcast provides a mock function with given fields: ctx, message, retryKey
+func (_m *MockPusher) Broadcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, message, retryKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Broadcast")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Message, uuid.UUID) (string, error)); ok {
+		return rf(ctx, message, retryKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Message, uuid.UUID) string); ok {
+		r0 = rf(ctx, message, retryKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Message, uuid.UUID) error); ok {
+		r1 = rf(ctx, message, retryKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPusher_Broadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Broadcast'
+type MockPusher_Broadcast_Call struct {
+	*mock.Call
+}
+
+// Broadcast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *model.Message
+//   - retryKey uuid.UUID
+func (_e *MockPusher_Expecter) Broadcast(ctx interface{}, message interface{}, retryKey interface{}) *MockPusher_Broadcast_Call {
+	return &MockPusher_Broadcast_Call{Call: _e.mock.On("Broadcast", ctx, message, retryKey)}
+}
+
+func (_c *MockPusher_Broadcast_Call) Run(run func(ctx context.Context, message *model.Message, retryKey uuid.UUID)) *MockPusher_Broadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Message), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPusher_Broadcast_Call) Return(_a0 string, _a1 error) *MockPusher_Broadcast_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPusher_Broadcast_Call) RunAndReturn(run func(context.Context, *model.Message, uuid.UUID) (string, error)) *MockPusher_Broadcast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReachableFollowers provides a mock function with given fields: ctx
+func (_m *MockPusher) CountReachableFollowers(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReachableFollowers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPusher_CountReachableFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReachableFollowers'
+type MockPusher_CountReachableFollowers_Call struct {
+	*mock.Call
+}
+
+// CountReachableFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPusher_Expecter) CountReachableFollowers(ctx interface{}) *MockPusher_CountReachableFollowers_Call {
+	return &MockPusher_CountReachableFollowers_Call{Call: _e.mock.On("CountReachableFollowers", ctx)}
+}
+
+func (_c *MockPusher_CountReachableFollowers_Call) Run(run func(ctx context.Context)) *MockPusher_CountReachableFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPusher_CountReachableFollowers_Call) Return(_a0 int, _a1 error) *MockPusher_CountReachableFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPusher_CountReachableFollowers_Call) RunAndReturn(run func(context.Context) (int, error)) *MockPusher_CountReachableFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNarrowcastProgress provides a mock function with given fields: ctx, requestID
+func (_m *MockPusher) GetNarrowcastProgress(ctx context.Context, requestID string) (*model.NarrowcastProgress, error) {
+	ret := _m.Called(ctx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNarrowcastProgress")
+	}
+
+	var r0 *model.NarrowcastProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.NarrowcastProgress, error)); ok {
+		return rf(ctx, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.NarrowcastProgress); ok {
+		r0 = rf(ctx, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NarrowcastProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPusher_GetNarrowcastProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNarrowcastProgress'
+type MockPusher_GetNarrowcastProgress_Call struct {
+	*mock.Call
+}
+
+// GetNarrowcastProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestID string
+func (_e *MockPusher_Expecter) GetNarrowcastProgress(ctx interface{}, requestID interface{}) *MockPusher_GetNarrowcastProgress_Call {
+	return &MockPusher_GetNarrowcastProgress_Call{Call: _e.mock.On("GetNarrowcastProgress", ctx, requestID)}
+}
+
+func (_c *MockPusher_GetNarrowcastProgress_Call) Run(run func(ctx context.Context, requestID string)) *MockPusher_GetNarrowcastProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPusher_GetNarrowcastProgress_Call) Return(_a0 *model.NarrowcastProgress, _a1 error) *MockPusher_GetNarrowcastProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPusher_GetNarrowcastProgress_Call) RunAndReturn(run func(context.Context, string) (*model.NarrowcastProgress, error)) *MockPusher_GetNarrowcastProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuota provides a mock function with given fields: ctx
+func (_m *MockPusher) GetQuota(ctx context.Context) (*model.MessageQuota, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuota")
+	}
+
+	var r0 *model.MessageQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.MessageQuota, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.MessageQuota); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MessageQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPusher_GetQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuota'
+type MockPusher_GetQuota_Call struct {
+	*mock.Call
+}
+
+// GetQuota is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPusher_Expecter) GetQuota(ctx interface{}) *MockPusher_GetQuota_Call {
+	return &MockPusher_GetQuota_Call{Call: _e.mock.On("GetQuota", ctx)}
+}
+
+func (_c *MockPusher_GetQuota_Call) Run(run func(ctx context.Context)) *MockPusher_GetQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPusher_GetQuota_Call) Return(_a0 *model.MessageQuota, _a1 error) *MockPusher_GetQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPusher_GetQuota_Call) RunAndReturn(run func(context.Context) (*model.MessageQuota, error)) *MockPusher_GetQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Multicast provides a mock function with given fields: ctx, message, to, retryKey
+func (_m *MockPusher) Multicast(ctx context.Context, message *model.Message, to model.Recipients, retryKey uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, message, to, retryKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Multicast")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Message, model.Recipients, uuid.UUID) (string, error)); ok {
+		return rf(ctx, message, to, retryKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Message, model.Recipients, uuid.UUID) string); ok {
+		r0 = rf(ctx, message, to, retryKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Message, model.Recipients, uuid.UUID) error); ok {
+		r1 = rf(ctx, message, to, retryKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPusher_Multicast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Multicast'
+type MockPusher_Multicast_Call struct {
+	*mock.Call
+}
+
+// Multicast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *model.Message
+//   - to model.Recipients
+//   - retryKey uuid.UUID
+func (_e *MockPusher_Expecter) Multicast(ctx interface{}, message interface{}, to interface{}, retryKey interface{}) *MockPusher_Multicast_Call {
+	return &MockPusher_Multicast_Call{Call: _e.mock.On("Multicast", ctx, message, to, retryKey)}
+}
+
+func (_c *MockPusher_Multicast_Call) Run(run func(ctx context.Context, message *model.Message, to model.Recipients, retryKey uuid.UUID)) *MockPusher_Multicast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Message), args[2].(model.Recipients), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPusher_Multicast_Call) Return(_a0 string, _a1 error) *MockPusher_Multicast_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPusher_Multicast_Call) RunAndReturn(run func(context.Context, *model.Message, model.Recipients, uuid.UUID) (string, error)) *MockPusher_Multicast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Narrowcast provides a mock function with given fields: ctx, message, retryKey
+func (_m *MockPusher) Narrowcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, message, retryKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Narrowcast")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Message, uuid.UUID) (string, error)); ok {
+		return rf(ctx, message, retryKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Message, uuid.UUID) string); ok {
+		r0 = rf(ctx, message, retryKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Message, uuid.UUID) error); ok {
+		r1 = rf(ctx, message, retryKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPusher_Narrowcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Narrowcast'
+type MockPusher_Narrowcast_Call struct {
+	*mock.Call
+}
+
+// Narrowcast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *model.Message
+//   - retryKey uuid.UUID
+func (_e *MockPusher_Expecter) Narrowcast(ctx interface{}, message interface{}, retryKey interface{}) *MockPusher_Narrowcast_Call {
+	return &MockPusher_Narrowcast_Call{Call: _e.mock.On("Narrowcast", ctx, message, retryKey)}
+}
+
+func (_c *MockPusher_Narrowcast_Call) Run(run func(ctx context.Context, message *model.Message, retryKey uuid.UUID)) *MockPusher_Narrowcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Message), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPusher_Narrowcast_Call) Return(_a0 string, _a1 error) *MockPusher_Narrowcast_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPusher_Narrowcast_Call) RunAndReturn(run func(context.Context, *model.Message, uuid.UUID) (string, error)) *MockPusher_Narrowcast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PushMessage provides a mock function with given fields: ctx, message
+func (_m *MockPusher) PushMessage(ctx context.Context, message *model.Message) error {
+	ret := _m.Called(ctx, message)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PushMessage")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, title, body)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Message) error); ok {
+		r0 = rf(ctx, message)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,15 +398,14 @@ type MockPusher_PushMessage_Call struct {
 
 // PushMessage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - title string
-//   - body string
-func (_e *MockPusher_Expecter) PushMessage(ctx interface{}, title interface{}, body interface{}) *MockPusher_PushMessage_Call {
-	return &MockPusher_PushMessage_Call{Call: _e.mock.On("PushMessage", ctx, title, body)}
+//   - message *model.Message
+func (_e *MockPusher_Expecter) PushMessage(ctx interface{}, message interface{}) *MockPusher_PushMessage_Call {
+	return &MockPusher_PushMessage_Call{Call: _e.mock.On("PushMessage", ctx, message)}
 }
 
-func (_c *MockPusher_PushMessage_Call) Run(run func(ctx context.Context, title string, body string)) *MockPusher_PushMessage_Call {
+func (_c *MockPusher_PushMessage_Call) Run(run func(ctx context.Context, message *model.Message)) *MockPusher_PushMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(*model.Message))
 	})
 	return _c
 }
@@ -64,7 +415,7 @@ func (_c *MockPusher_PushMessage_Call) Return(_a0 error) *MockPusher_PushMessage
 	return _c
 }
 
-func (_c *MockPusher_PushMessage_Call) RunAndReturn(run func(context.Context, string, string) error) *MockPusher_PushMessage_Call {
+func (_c *MockPusher_PushMessage_Call) RunAndReturn(run func(context.Context, *model.Message) error) *MockPusher_PushMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -116,6 +467,54 @@ func (_c *MockPusher_PushText_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
+// Reply provides a mock function with given fields: ctx, replyToken, replies
+func (_m *MockPusher) Reply(ctx context.Context, replyToken string, replies model.MessageParts) error {
+	ret := _m.Called(ctx, replyToken, replies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.MessageParts) error); ok {
+		r0 = rf(ctx, replyToken, replies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPusher_Reply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reply'
+type MockPusher_Reply_Call struct {
+	*mock.Call
+}
+
+// Reply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - replyToken string
+//   - replies model.MessageParts
+func (_e *MockPusher_Expecter) Reply(ctx interface{}, replyToken interface{}, replies interface{}) *MockPusher_Reply_Call {
+	return &MockPusher_Reply_Call{Call: _e.mock.On("Reply", ctx, replyToken, replies)}
+}
+
+func (_c *MockPusher_Reply_Call) Run(run func(ctx context.Context, replyToken string, replies model.MessageParts)) *MockPusher_Reply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.MessageParts))
+	})
+	return _c
+}
+
+func (_c *MockPusher_Reply_Call) Return(_a0 error) *MockPusher_Reply_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPusher_Reply_Call) RunAndReturn(run func(context.Context, string, model.MessageParts) error) *MockPusher_Reply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockPusher creates a new instance of MockPusher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockPusher(t interface {
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type ProfileClient interface {
+	// GetProfile 友だちのプロフィールを取得（ブロックされている場合は取得できない）
+	GetProfile(ctx context.Context, userID string) (*model.LineProfile, error)
+}
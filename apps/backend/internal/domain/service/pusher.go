@@ -2,12 +2,37 @@ package service
 
 import (
 	"context"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
 )
 
 type Pusher interface {
 	// PushText テキストメッセージを送信
 	PushText(ctx context.Context, text string) error
 
-	// PushMessage より詳細なメッセージを送信
-	PushMessage(ctx context.Context, title, body string) error
+	// PushMessage メッセージを送信（リッチコンテンツがあればその種別で送信）
+	PushMessage(ctx context.Context, message *model.Message) error
+
+	// Multicast 指定したユーザー（最大 model.MulticastMaxRecipients 件）にメッセージを送信し、LINEのリクエストIDを返す。
+	// retryKey が同じリクエストは、LINEが既に受け付けていれば重複して配信されない
+	Multicast(ctx context.Context, message *model.Message, to model.Recipients, retryKey uuid.UUID) (string, error)
+
+	// Reply Webhookイベントの応答トークンを使って応答メッセージ（最大5件）を送信
+	Reply(ctx context.Context, replyToken string, replies model.MessageParts) error
+
+	// Broadcast 友だち全員にメッセージを送信し、LINEのリクエストIDを返す（retryKey は Multicast と同じ）
+	Broadcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error)
+
+	// Narrowcast 絞り込み配信を依頼し、LINEのリクエストIDを返す（送信はLINE側で非同期に進む。retryKey は Multicast と同じ）
+	Narrowcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error)
+
+	// GetNarrowcastProgress 絞り込み配信の進捗を取得
+	GetNarrowcastProgress(ctx context.Context, requestID string) (*model.NarrowcastProgress, error)
+
+	// GetQuota 今月の送信数の上限と使用数を取得
+	GetQuota(ctx context.Context) (*model.MessageQuota, error)
+
+	// CountReachableFollowers ブロードキャストが届く友だち数（前日時点の集計）を取得
+	CountReachableFollowers(ctx context.Context) (int, error)
 }
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type RichMenuClient interface {
+	// CreateRichMenu リッチメニューを作成し、LINE上のリッチメニューIDを返す（画像は別途アップロードする）
+	CreateRichMenu(ctx context.Context, definition *model.RichMenuDefinition) (string, error)
+
+	// UploadRichMenuImage リッチメニューに画像をアップロード（1つのリッチメニューに1回だけ）
+	UploadRichMenuImage(ctx context.Context, richMenuID, contentType string, image []byte) error
+
+	// SetDefaultRichMenu 既定のリッチメニューを設定
+	SetDefaultRichMenu(ctx context.Context, richMenuID string) error
+
+	// LinkRichMenu ユーザー（最大 model.RichMenuLinkMaxUsers 件）にリッチメニューをリンク
+	LinkRichMenu(ctx context.Context, richMenuID string, userIDs []string) error
+
+	// UnlinkRichMenu ユーザー（最大 model.RichMenuLinkMaxUsers 件）のリッチメニューのリンクを解除（既定のリッチメニューが表示される）
+	UnlinkRichMenu(ctx context.Context, userIDs []string) error
+
+	// DeleteRichMenu リッチメニューを削除（存在しない場合は model.ErrLineRichMenuNotFound）
+	DeleteRichMenu(ctx context.Context, richMenuID string) error
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"vt-link/backend/internal/domain/model"
+)
+
+type WebhookParser interface {
+	// Parse リクエストボディの署名を検証してイベントを取り出す。
+	// 署名が一致しない場合は model.ErrInvalidSignature、チャネルシークレットが未設定の場合は model.ErrWebhookNotConfigured を返す
+	Parse(body []byte, signature string) (*model.WebhookPayload, error)
+}
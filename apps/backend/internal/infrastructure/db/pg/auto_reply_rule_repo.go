@@ -0,0 +1,164 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type AutoReplyRuleRepository struct {
+	db *db.DB
+}
+
+func NewAutoReplyRuleRepository(db *db.DB) repository.AutoReplyRuleRepository {
+	return &AutoReplyRuleRepository{db: db}
+}
+
+const autoReplyRuleColumns = `id, name, match_type, keywords, replies, priority, enabled, time_window, timezone, created_at, updated_at`
+
+func (r *AutoReplyRuleRepository) Create(ctx context.Context, rule *model.AutoReplyRule) error {
+	query := `
+		INSERT INTO auto_reply_rules (` + autoReplyRuleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query,
+		rule.ID,
+		rule.Name,
+		rule.MatchType,
+		rule.Keywords,
+		rule.Replies,
+		rule.Priority,
+		rule.Enabled,
+		rule.Window,
+		rule.Timezone,
+		rule.CreatedAt,
+		rule.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auto-reply rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AutoReplyRuleRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.AutoReplyRule, error) {
+	query := `SELECT ` + autoReplyRuleColumns + ` FROM auto_reply_rules WHERE id = $1`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var rule model.AutoReplyRule
+	err := sqlx.GetContext(ctx, executor, &rule, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("auto-reply rule not found")
+		}
+		return nil, fmt.Errorf("failed to find auto-reply rule: %w", err)
+	}
+
+	return &rule, nil
+}
+
+func (r *AutoReplyRuleRepository) List(ctx context.Context, limit, offset int) ([]*model.AutoReplyRule, error) {
+	query := `
+		SELECT ` + autoReplyRuleColumns + `
+		FROM auto_reply_rules
+		ORDER BY priority DESC, created_at ASC, id ASC
+		LIMIT $1 OFFSET $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var rules []*model.AutoReplyRule
+	err := sqlx.SelectContext(ctx, executor, &rules, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auto-reply rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *AutoReplyRuleRepository) ListEnabled(ctx context.Context) ([]*model.AutoReplyRule, error) {
+	query := `
+		SELECT ` + autoReplyRuleColumns + `
+		FROM auto_reply_rules
+		WHERE enabled
+		ORDER BY priority DESC, created_at ASC, id ASC
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var rules []*model.AutoReplyRule
+	err := sqlx.SelectContext(ctx, executor, &rules, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enabled auto-reply rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *AutoReplyRuleRepository) Update(ctx context.Context, rule *model.AutoReplyRule) error {
+	query := `
+		UPDATE auto_reply_rules
+		SET name = $2, match_type = $3, keywords = $4, replies = $5, priority = $6, enabled = $7, time_window = $8, timezone = $9, updated_at = $10
+		WHERE id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query,
+		rule.ID,
+		rule.Name,
+		rule.MatchType,
+		rule.Keywords,
+		rule.Replies,
+		rule.Priority,
+		rule.Enabled,
+		rule.Window,
+		rule.Timezone,
+		rule.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auto-reply rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("auto-reply rule not found")
+	}
+
+	return nil
+}
+
+func (r *AutoReplyRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM auto_reply_rules WHERE id = $1`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete auto-reply rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("auto-reply rule not found")
+	}
+
+	return nil
+}
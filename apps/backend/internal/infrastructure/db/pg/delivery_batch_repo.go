@@ -0,0 +1,151 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type DeliveryBatchRepository struct {
+	db *db.DB
+}
+
+func NewDeliveryBatchRepository(db *db.DB) repository.DeliveryBatchRepository {
+	return &DeliveryBatchRepository{db: db}
+}
+
+func (r *DeliveryBatchRepository) CreateBatches(ctx context.Context, batches []*model.DeliveryBatch) error {
+	query := `
+		INSERT INTO message_delivery_batches (id, message_id, batch_index, target, recipients, status, audience, request_id, error, attempts, sent_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	for _, batch := range batches {
+		_, err := executor.ExecContext(ctx, query,
+			batch.ID,
+			batch.MessageID,
+			batch.Index,
+			batch.Target,
+			batch.Recipients,
+			batch.Status,
+			batch.Audience,
+			batch.RequestID,
+			batch.Error,
+			batch.Attempts,
+			batch.SentAt,
+			batch.CreatedAt,
+			batch.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create delivery batch: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *DeliveryBatchRepository) ListByMessage(ctx context.Context, messageID uuid.UUID) ([]*model.DeliveryBatch, error) {
+	query := `
+		SELECT id, message_id, batch_index, target, recipients, status, audience, request_id, error, attempts, sent_at, created_at, updated_at
+		FROM message_delivery_batches
+		WHERE message_id = $1
+		ORDER BY batch_index ASC
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var batches []*model.DeliveryBatch
+	err := sqlx.SelectContext(ctx, executor, &batches, query, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery batches: %w", err)
+	}
+
+	return batches, nil
+}
+
+func (r *DeliveryBatchRepository) FindAccepted(ctx context.Context, limit int) ([]*model.DeliveryBatch, error) {
+	query := `
+		SELECT id, message_id, batch_index, target, recipients, status, audience, request_id, error, attempts, sent_at, created_at, updated_at
+		FROM message_delivery_batches
+		WHERE status = 'accepted'
+		ORDER BY updated_at ASC
+		LIMIT $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var batches []*model.DeliveryBatch
+	err := sqlx.SelectContext(ctx, executor, &batches, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accepted delivery batches: %w", err)
+	}
+
+	return batches, nil
+}
+
+func (r *DeliveryBatchRepository) Update(ctx context.Context, batch *model.DeliveryBatch) error {
+	query := `
+		UPDATE message_delivery_batches
+		SET status = $2, audience = $3, request_id = $4, error = $5, attempts = $6, sent_at = $7, updated_at = $8
+		WHERE id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query,
+		batch.ID,
+		batch.Status,
+		batch.Audience,
+		batch.RequestID,
+		batch.Error,
+		batch.Attempts,
+		batch.SentAt,
+		batch.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update delivery batch: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("delivery batch not found")
+	}
+
+	return nil
+}
+
+func (r *DeliveryBatchRepository) SumAudience(ctx context.Context, since time.Time) (map[model.DeliveryTarget]int, error) {
+	query := `
+		SELECT target, COALESCE(SUM(audience), 0) AS audience
+		FROM message_delivery_batches
+		WHERE status = 'succeeded' AND sent_at >= $1
+		GROUP BY target
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var rows []struct {
+		Target   model.DeliveryTarget `db:"target"`
+		Audience int                  `db:"audience"`
+	}
+	err := sqlx.SelectContext(ctx, executor, &rows, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum delivery audience: %w", err)
+	}
+
+	usage := make(map[model.DeliveryTarget]int, len(rows))
+	for _, row := range rows {
+		usage[row.Target] = row.Audience
+	}
+	return usage, nil
+}
@@ -0,0 +1,141 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type FollowerRepository struct {
+	db *db.DB
+}
+
+func NewFollowerRepository(db *db.DB) repository.FollowerRepository {
+	return &FollowerRepository{db: db}
+}
+
+const followerColumns = `id, line_user_id, display_name, picture_url, status_message, language, status, followed_at, unfollowed_at, profile_updated_at, created_at, updated_at`
+
+func (r *FollowerRepository) Save(ctx context.Context, follower *model.Follower) error {
+	// 同じユーザーのイベントが同時に届いた場合も1件にまとめる（登録済みの行のIDを引き継ぐ）
+	query := `
+		INSERT INTO followers (` + followerColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (line_user_id) DO UPDATE
+		SET display_name = EXCLUDED.display_name, picture_url = EXCLUDED.picture_url, status_message = EXCLUDED.status_message,
+			language = EXCLUDED.language, status = EXCLUDED.status, followed_at = EXCLUDED.followed_at,
+			unfollowed_at = EXCLUDED.unfollowed_at, profile_updated_at = EXCLUDED.profile_updated_at, updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	err := sqlx.GetContext(ctx, executor, follower, query,
+		follower.ID,
+		follower.LineUserID,
+		follower.DisplayName,
+		follower.PictureURL,
+		follower.StatusMessage,
+		follower.Language,
+		follower.Status,
+		follower.FollowedAt,
+		follower.UnfollowedAt,
+		follower.ProfileUpdatedAt,
+		follower.CreatedAt,
+		follower.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to save follower: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FollowerRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Follower, error) {
+	return r.findOne(ctx, "id = $1", id)
+}
+
+func (r *FollowerRepository) FindByLineUserID(ctx context.Context, lineUserID string) (*model.Follower, error) {
+	return r.findOne(ctx, "line_user_id = $1", lineUserID)
+}
+
+func (r *FollowerRepository) findOne(ctx context.Context, condition string, arg interface{}) (*model.Follower, error) {
+	query := `SELECT ` + followerColumns + ` FROM followers WHERE ` + condition
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var follower model.Follower
+	err := sqlx.GetContext(ctx, executor, &follower, query, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("follower not found")
+		}
+		return nil, fmt.Errorf("failed to find follower: %w", err)
+	}
+
+	return &follower, nil
+}
+
+func (r *FollowerRepository) List(ctx context.Context, filter *model.FollowerFilter, limit, offset int) ([]*model.Follower, error) {
+	where, args := followerFilterSQL(filter)
+	args = append(args, limit, offset)
+
+	query := `SELECT ` + followerColumns + `
+		FROM followers` + whereSQL(where) + fmt.Sprintf(`
+		ORDER BY followed_at DESC NULLS LAST, id DESC
+		LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var followers []*model.Follower
+	err := sqlx.SelectContext(ctx, executor, &followers, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list followers: %w", err)
+	}
+
+	return followers, nil
+}
+
+func (r *FollowerRepository) Count(ctx context.Context, filter *model.FollowerFilter) (int, error) {
+	where, args := followerFilterSQL(filter)
+	query := `SELECT COUNT(*) FROM followers` + whereSQL(where)
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var count int
+	err := sqlx.GetContext(ctx, executor, &count, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count followers: %w", err)
+	}
+
+	return count, nil
+}
+
+// followerFilterSQL 絞り込み条件をWHERE句の条件とパラメータに変換
+func followerFilterSQL(filter *model.FollowerFilter) ([]string, []interface{}) {
+	var where []string
+	var args []interface{}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	if filter.Language != "" {
+		args = append(args, filter.Language)
+		where = append(where, fmt.Sprintf("language = $%d", len(args)))
+	}
+
+	if filter.Query != "" {
+		args = append(args, "%"+likeEscaper.Replace(filter.Query)+"%", filter.Query)
+		where = append(where, fmt.Sprintf("(display_name ILIKE $%d OR line_user_id = $%d)", len(args)-1, len(args)))
+	}
+
+	return where, args
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"vt-link/backend/internal/domain/model"
 	"vt-link/backend/internal/domain/repository"
 	"vt-link/backend/internal/infrastructure/db"
@@ -21,22 +23,46 @@ func NewMessageRepository(db *db.DB) repository.MessageRepository {
 	return &MessageRepository{db: db}
 }
 
+// insertRevisionSQL 直前のCTE（m）で書き込んだ行を m.revision の番号でリビジョンとして記録する（変更者は $changedByParam）。
+// 同一文で実行するため、トランザクション外の呼び出しでも本体とリビジョンがずれない
+func insertRevisionSQL(changedByParam int) string {
+	return fmt.Sprintf(`
+		INSERT INTO message_revisions (message_id, revision, title, message, content, parts, quick_reply, status, scheduled_at, timezone, changed_by, created_at)
+		SELECT m.id, m.revision, m.title, m.message, m.content, m.parts, m.quick_reply, m.status, m.scheduled_at, m.timezone, NULLIF($%d, ''), m.updated_at
+		FROM m
+`, changedByParam)
+}
+
 func (r *MessageRepository) Create(ctx context.Context, message *model.Message) error {
 	query := `
-		INSERT INTO messages (id, title, message, status, scheduled_at, sent_at, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-	`
+		WITH m AS (
+			INSERT INTO messages (id, title, message, content, parts, quick_reply, target, recipients, narrowcast, delivery_counts, template_id, source_message_id, status, scheduled_at, timezone, sent_at, deleted_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+			RETURNING *
+		)` + insertRevisionSQL(20)
 
 	executor := db.GetExecutor(ctx, r.db)
 	_, err := executor.ExecContext(ctx, query,
 		message.ID,
 		message.Title,
 		message.Body,
+		message.Content,
+		message.Parts,
+		message.QuickReply,
+		message.Target,
+		message.Recipients,
+		message.Narrowcast,
+		message.DeliveryCounts,
+		message.TemplateID,
+		message.SourceMessageID,
 		message.Status,
 		message.ScheduledAt,
+		message.Timezone,
 		message.SentAt,
+		message.DeletedAt,
 		message.CreatedAt,
 		message.UpdatedAt,
+		message.ChangedBy,
 	)
 
 	if err != nil {
@@ -47,11 +73,19 @@ func (r *MessageRepository) Create(ctx context.Context, message *model.Message)
 }
 
 func (r *MessageRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	return r.findByID(ctx, id, "")
+}
+
+func (r *MessageRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Message, error) {
+	return r.findByID(ctx, id, "FOR UPDATE")
+}
+
+func (r *MessageRepository) findByID(ctx context.Context, id uuid.UUID, lock string) (*model.Message, error) {
 	query := `
-		SELECT id, title, message, status, scheduled_at, sent_at, created_at, updated_at
+		SELECT id, title, message, content, parts, quick_reply, target, recipients, narrowcast, delivery_counts, template_id, source_message_id, status, scheduled_at, timezone, sent_at, deleted_at, created_at, updated_at
 		FROM messages
 		WHERE id = $1
-	`
+	` + lock
 
 	executor := db.GetExecutor(ctx, r.db)
 
@@ -64,44 +98,206 @@ func (r *MessageRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.
 		return nil, fmt.Errorf("failed to find message: %w", err)
 	}
 
+	if err := attachTags(ctx, executor, []*model.Message{&message}); err != nil {
+		return nil, err
+	}
+
 	return &message, nil
 }
 
-func (r *MessageRepository) List(ctx context.Context, limit, offset int) ([]*model.Message, error) {
-	query := `
-		SELECT id, title, message, status, scheduled_at, sent_at, created_at, updated_at
-		FROM messages
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
+func (r *MessageRepository) List(ctx context.Context, query *model.MessageQuery) ([]*model.Message, error) {
+	where, args := messageFilterSQL(&query.Filter)
+
+	column := string(query.Sort.Field)
+	op, direction := ">", "ASC"
+	if query.Sort.Desc {
+		op, direction = "<", "DESC"
+	}
+
+	pagination := ""
+	if query.Cursor != nil {
+		value, err := query.Cursor.KeyValue(query.Sort.Field)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list messages: %w", err)
+		}
+		// NULL は昇順・降順とも末尾に並ぶため、NULL のカーソル以降は NULL の行だけが続く
+		args = append(args, query.Cursor.ID)
+		idParam := len(args)
+		if value == nil {
+			where = append(where, fmt.Sprintf("%s IS NULL AND id %s $%d", column, op, idParam))
+		} else {
+			args = append(args, value)
+			where = append(where, fmt.Sprintf("(%[1]s %[2]s $%[3]d OR (%[1]s = $%[3]d AND id %[2]s $%[4]d) OR %[1]s IS NULL)", column, op, len(args), idParam))
+		}
+	} else if query.Offset > 0 {
+		args = append(args, query.Offset)
+		pagination = fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+	args = append(args, query.Limit)
+
+	sqlQuery := `
+		SELECT id, title, message, content, parts, quick_reply, target, recipients, narrowcast, delivery_counts, template_id, source_message_id, status, scheduled_at, timezone, sent_at, deleted_at, created_at, updated_at
+		FROM messages` + whereSQL(where) + fmt.Sprintf(`
+		ORDER BY %[1]s %[2]s NULLS LAST, id %[2]s
+		LIMIT $%[3]d`, column, direction, len(args)) + pagination
 
 	executor := db.GetExecutor(ctx, r.db)
 
 	var messages []*model.Message
-	err := sqlx.SelectContext(ctx, executor, &messages, query, limit, offset)
+	err := sqlx.SelectContext(ctx, executor, &messages, sqlQuery, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list messages: %w", err)
 	}
 
+	if err := attachTags(ctx, executor, messages); err != nil {
+		return nil, err
+	}
+
 	return messages, nil
 }
 
-func (r *MessageRepository) Update(ctx context.Context, message *model.Message) error {
+func (r *MessageRepository) Count(ctx context.Context, filter *model.MessageFilter) (int, error) {
+	where, args := messageFilterSQL(filter)
+	query := `SELECT COUNT(*) FROM messages` + whereSQL(where)
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var count int
+	err := sqlx.GetContext(ctx, executor, &count, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count messages: %w", err)
+	}
+
+	return count, nil
+}
+
+// messageFilterSQL 絞り込み条件をWHERE句の条件とパラメータに変換
+func messageFilterSQL(filter *model.MessageFilter) ([]string, []interface{}) {
+	where := []string{"deleted_at IS NULL"}
+	if filter.Deleted {
+		where = []string{"deleted_at IS NOT NULL"}
+	}
+	var args []interface{}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+		args = append(args, pq.Array(statuses))
+		where = append(where, fmt.Sprintf("status = ANY($%d)", len(args)))
+	}
+
+	if len(filter.TagIDs) > 0 {
+		args = append(args, pq.Array(uuidStrings(filter.TagIDs)))
+		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM message_tags mt WHERE mt.message_id = messages.id AND mt.tag_id = ANY($%d::uuid[]))", len(args)))
+	}
+
+	if filter.SourceMessageID != nil {
+		args = append(args, *filter.SourceMessageID)
+		where = append(where, fmt.Sprintf("source_message_id = $%d", len(args)))
+	}
+
+	ranges := []struct {
+		column   string
+		from, to *time.Time
+	}{
+		{"scheduled_at", filter.ScheduledFrom, filter.ScheduledTo},
+		{"sent_at", filter.SentFrom, filter.SentTo},
+	}
+	for _, rng := range ranges {
+		if rng.from != nil {
+			args = append(args, *rng.from)
+			where = append(where, fmt.Sprintf("%s >= $%d", rng.column, len(args)))
+		}
+		if rng.to != nil {
+			args = append(args, *rng.to)
+			where = append(where, fmt.Sprintf("%s < $%d", rng.column, len(args)))
+		}
+	}
+
+	return where, args
+}
+
+func whereSQL(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "\n\t\tWHERE " + strings.Join(conditions, " AND ")
+}
+
+func (r *MessageRepository) Search(ctx context.Context, terms []string, limit, offset int) ([]*model.MessageSearchResult, error) {
+	// 各検索語はタイトルか本文のどちらかに含まれていればよい（pg_trgm の GIN インデックスが ILIKE に効く）
+	conditions := make([]string, len(terms))
+	args := []interface{}{strings.Join(terms, " "), limit, offset}
+	for i, term := range terms {
+		args = append(args, "%"+likeEscaper.Replace(term)+"%")
+		conditions[i] = fmt.Sprintf("(title || ' ' || message) ILIKE $%d", len(args))
+	}
+
 	query := `
-		UPDATE messages
-		SET title = $2, message = $3, status = $4, scheduled_at = $5, sent_at = $6, updated_at = $7
-		WHERE id = $1
+		SELECT id, title, message, content, parts, quick_reply, target, recipients, narrowcast, delivery_counts, template_id, source_message_id, status, scheduled_at, timezone, sent_at, deleted_at, created_at, updated_at,
+			word_similarity($1, title) * 2 + word_similarity($1, message) AS rank
+		FROM messages
+		WHERE deleted_at IS NULL AND ` + strings.Join(conditions, " AND ") + `
+		ORDER BY rank DESC, updated_at DESC
+		LIMIT $2 OFFSET $3
 	`
 
+	executor := db.GetExecutor(ctx, r.db)
+
+	var rows []struct {
+		model.Message
+		Rank float64 `db:"rank"`
+	}
+	err := sqlx.SelectContext(ctx, executor, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	results := make([]*model.MessageSearchResult, len(rows))
+	messages := make([]*model.Message, len(rows))
+	for i := range rows {
+		messages[i] = &rows[i].Message
+		results[i] = &model.MessageSearchResult{Message: messages[i], Rank: rows[i].Rank}
+	}
+	if err := attachTags(ctx, executor, messages); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// likeEscaper LIKE のワイルドカードを文字どおりに扱う
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *MessageRepository) Update(ctx context.Context, message *model.Message) error {
+	query := `
+		WITH m AS (
+			UPDATE messages
+			SET title = $2, message = $3, content = $4, parts = $5, quick_reply = $6, target = $7, recipients = $8, narrowcast = $9, delivery_counts = $10, status = $11, scheduled_at = $12, timezone = $13, sent_at = $14, deleted_at = $15, updated_at = $16, revision = revision + 1
+			WHERE id = $1
+			RETURNING *
+		)` + insertRevisionSQL(17)
+
 	executor := db.GetExecutor(ctx, r.db)
 	result, err := executor.ExecContext(ctx, query,
 		message.ID,
 		message.Title,
 		message.Body,
+		message.Content,
+		message.Parts,
+		message.QuickReply,
+		message.Target,
+		message.Recipients,
+		message.Narrowcast,
+		message.DeliveryCounts,
 		message.Status,
 		message.ScheduledAt,
+		message.Timezone,
 		message.SentAt,
+		message.DeletedAt,
 		message.UpdatedAt,
+		message.ChangedBy,
 	)
 
 	if err != nil {
@@ -120,11 +316,71 @@ func (r *MessageRepository) Update(ctx context.Context, message *model.Message)
 	return nil
 }
 
+func (r *MessageRepository) SetTags(ctx context.Context, messageID uuid.UUID, tagIDs []uuid.UUID) error {
+	executor := db.GetExecutor(ctx, r.db)
+
+	_, err := executor.ExecContext(ctx, `DELETE FROM message_tags WHERE message_id = $1`, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to clear message tags: %w", err)
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO message_tags (message_id, tag_id)
+		SELECT $1, tag_id FROM UNNEST($2::uuid[]) AS tag_id
+		ON CONFLICT DO NOTHING
+	`
+	_, err = executor.ExecContext(ctx, query, messageID, pq.Array(uuidStrings(tagIDs)))
+	if err != nil {
+		return fmt.Errorf("failed to set message tags: %w", err)
+	}
+
+	return nil
+}
+
+// attachTags メッセージに割り当てられたタグをまとめて読み込む
+func attachTags(ctx context.Context, executor sqlx.QueryerContext, messages []*model.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(messages))
+	for i, message := range messages {
+		ids[i] = message.ID
+	}
+
+	query := `
+		SELECT mt.message_id, t.id, t.name, t.color, t.created_at, t.updated_at
+		FROM message_tags mt
+		JOIN tags t ON t.id = mt.tag_id
+		WHERE mt.message_id = ANY($1::uuid[])
+		ORDER BY t.name ASC
+	`
+
+	var rows []struct {
+		MessageID uuid.UUID `db:"message_id"`
+		model.Tag
+	}
+	if err := sqlx.SelectContext(ctx, executor, &rows, query, pq.Array(uuidStrings(ids))); err != nil {
+		return fmt.Errorf("failed to load message tags: %w", err)
+	}
+
+	tags := map[uuid.UUID][]*model.Tag{}
+	for i := range rows {
+		tags[rows[i].MessageID] = append(tags[rows[i].MessageID], &rows[i].Tag)
+	}
+	for _, message := range messages {
+		message.Tags = tags[message.ID]
+	}
+	return nil
+}
+
 func (r *MessageRepository) FindScheduledMessages(ctx context.Context, until time.Time, limit int) ([]*model.Message, error) {
 	query := `
-		SELECT id, title, message, status, scheduled_at, sent_at, created_at, updated_at
+		SELECT id, title, message, content, parts, quick_reply, target, recipients, narrowcast, delivery_counts, template_id, source_message_id, status, scheduled_at, timezone, sent_at, deleted_at, created_at, updated_at
 		FROM messages
-		WHERE status = 'scheduled' AND scheduled_at <= $1
+		WHERE status = 'scheduled' AND scheduled_at <= $1 AND deleted_at IS NULL
 		ORDER BY scheduled_at ASC
 		LIMIT $2
 	`
@@ -139,3 +395,67 @@ func (r *MessageRepository) FindScheduledMessages(ctx context.Context, until tim
 
 	return messages, nil
 }
+
+func (r *MessageRepository) FindStuckSending(ctx context.Context, before time.Time, limit int) ([]*model.Message, error) {
+	query := `
+		SELECT id, title, message, content, parts, quick_reply, target, recipients, narrowcast, delivery_counts, template_id, source_message_id, status, scheduled_at, timezone, sent_at, deleted_at, created_at, updated_at
+		FROM messages m
+		WHERE status = 'sending' AND updated_at <= $1
+			AND NOT EXISTS (
+				SELECT 1 FROM message_delivery_batches b
+				WHERE b.message_id = m.id AND b.status = 'accepted'
+			)
+		ORDER BY updated_at ASC
+		LIMIT $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var messages []*model.Message
+	err := sqlx.SelectContext(ctx, executor, &messages, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stuck sending messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *MessageRepository) ListRevisions(ctx context.Context, messageID uuid.UUID) ([]*model.MessageRevision, error) {
+	query := `
+		SELECT id, message_id, revision, title, message, content, parts, quick_reply, status, scheduled_at, timezone, changed_by, created_at
+		FROM message_revisions
+		WHERE message_id = $1
+		ORDER BY revision DESC
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var revisions []*model.MessageRevision
+	err := sqlx.SelectContext(ctx, executor, &revisions, query, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list message revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+func (r *MessageRepository) FindRevision(ctx context.Context, messageID uuid.UUID, revision int) (*model.MessageRevision, error) {
+	query := `
+		SELECT id, message_id, revision, title, message, content, parts, quick_reply, status, scheduled_at, timezone, changed_by, created_at
+		FROM message_revisions
+		WHERE message_id = $1 AND revision = $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var found model.MessageRevision
+	err := sqlx.GetContext(ctx, executor, &found, query, messageID, revision)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("message revision not found")
+		}
+		return nil, fmt.Errorf("failed to find message revision: %w", err)
+	}
+
+	return &found, nil
+}
@@ -0,0 +1,137 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type MessageTemplateRepository struct {
+	db *db.DB
+}
+
+func NewMessageTemplateRepository(db *db.DB) repository.MessageTemplateRepository {
+	return &MessageTemplateRepository{db: db}
+}
+
+func (r *MessageTemplateRepository) Create(ctx context.Context, template *model.MessageTemplate) error {
+	query := `
+		INSERT INTO message_templates (id, name, title, body, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query,
+		template.ID,
+		template.Name,
+		template.Title,
+		template.Body,
+		template.CreatedAt,
+		template.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create message template: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MessageTemplateRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.MessageTemplate, error) {
+	query := `
+		SELECT id, name, title, body, created_at, updated_at
+		FROM message_templates
+		WHERE id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var template model.MessageTemplate
+	err := sqlx.GetContext(ctx, executor, &template, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("message template not found")
+		}
+		return nil, fmt.Errorf("failed to find message template: %w", err)
+	}
+
+	return &template, nil
+}
+
+func (r *MessageTemplateRepository) List(ctx context.Context, limit, offset int) ([]*model.MessageTemplate, error) {
+	query := `
+		SELECT id, name, title, body, created_at, updated_at
+		FROM message_templates
+		ORDER BY name ASC, created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var templates []*model.MessageTemplate
+	err := sqlx.SelectContext(ctx, executor, &templates, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list message templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (r *MessageTemplateRepository) Update(ctx context.Context, template *model.MessageTemplate) error {
+	query := `
+		UPDATE message_templates
+		SET name = $2, title = $3, body = $4, updated_at = $5
+		WHERE id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query,
+		template.ID,
+		template.Name,
+		template.Title,
+		template.Body,
+		template.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update message template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("message template not found")
+	}
+
+	return nil
+}
+
+func (r *MessageTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM message_templates WHERE id = $1`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete message template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("message template not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type RecurringScheduleRepository struct {
+	db *db.DB
+}
+
+func NewRecurringScheduleRepository(db *db.DB) repository.RecurringScheduleRepository {
+	return &RecurringScheduleRepository{db: db}
+}
+
+func (r *RecurringScheduleRepository) Create(ctx context.Context, schedule *model.RecurringSchedule) error {
+	query := `
+		INSERT INTO recurring_schedules (id, message_id, expression, timezone, status, starts_at, next_run_at, last_run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query,
+		schedule.ID,
+		schedule.MessageID,
+		schedule.Expression,
+		schedule.Timezone,
+		schedule.Status,
+		schedule.StartsAt,
+		schedule.NextRunAt,
+		schedule.LastRunAt,
+		schedule.CreatedAt,
+		schedule.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create recurring schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RecurringScheduleRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RecurringSchedule, error) {
+	query := `
+		SELECT id, message_id, expression, timezone, status, starts_at, next_run_at, last_run_at, created_at, updated_at
+		FROM recurring_schedules
+		WHERE id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var schedule model.RecurringSchedule
+	err := sqlx.GetContext(ctx, executor, &schedule, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("recurring schedule not found")
+		}
+		return nil, fmt.Errorf("failed to find recurring schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+func (r *RecurringScheduleRepository) List(ctx context.Context, limit, offset int) ([]*model.RecurringSchedule, error) {
+	query := `
+		SELECT id, message_id, expression, timezone, status, starts_at, next_run_at, last_run_at, created_at, updated_at
+		FROM recurring_schedules
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var schedules []*model.RecurringSchedule
+	err := sqlx.SelectContext(ctx, executor, &schedules, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+func (r *RecurringScheduleRepository) Update(ctx context.Context, schedule *model.RecurringSchedule) error {
+	query := `
+		UPDATE recurring_schedules
+		SET expression = $2, timezone = $3, status = $4, starts_at = $5, next_run_at = $6, last_run_at = $7, updated_at = $8
+		WHERE id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query,
+		schedule.ID,
+		schedule.Expression,
+		schedule.Timezone,
+		schedule.Status,
+		schedule.StartsAt,
+		schedule.NextRunAt,
+		schedule.LastRunAt,
+		schedule.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update recurring schedule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("recurring schedule not found")
+	}
+
+	return nil
+}
+
+func (r *RecurringScheduleRepository) AdvanceIfDue(ctx context.Context, schedule *model.RecurringSchedule, nextRunAt time.Time) (bool, error) {
+	query := `
+		UPDATE recurring_schedules
+		SET next_run_at = $2, last_run_at = $3, updated_at = $4
+		WHERE id = $1 AND status = 'active' AND next_run_at = $5
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query,
+		schedule.ID,
+		schedule.NextRunAt,
+		schedule.LastRunAt,
+		schedule.UpdatedAt,
+		nextRunAt,
+	)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to update recurring schedule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *RecurringScheduleRepository) PauseIfDue(ctx context.Context, schedule *model.RecurringSchedule, nextRunAt time.Time) (bool, error) {
+	query := `
+		UPDATE recurring_schedules
+		SET status = 'paused', updated_at = $2
+		WHERE id = $1 AND status = 'active' AND next_run_at = $3
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query, schedule.ID, schedule.UpdatedAt, nextRunAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to pause recurring schedule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *RecurringScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM recurring_schedules WHERE id = $1`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete recurring schedule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("recurring schedule not found")
+	}
+
+	return nil
+}
+
+func (r *RecurringScheduleRepository) FindDueSchedules(ctx context.Context, until time.Time, limit int) ([]*model.RecurringSchedule, error) {
+	query := `
+		SELECT id, message_id, expression, timezone, status, starts_at, next_run_at, last_run_at, created_at, updated_at
+		FROM recurring_schedules
+		WHERE status = 'active' AND next_run_at <= $1
+		ORDER BY next_run_at ASC
+		LIMIT $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var schedules []*model.RecurringSchedule
+	err := sqlx.SelectContext(ctx, executor, &schedules, query, until, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due recurring schedules: %w", err)
+	}
+
+	return schedules, nil
+}
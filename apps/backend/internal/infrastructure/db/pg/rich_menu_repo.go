@@ -0,0 +1,266 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type RichMenuRepository struct {
+	db *db.DB
+}
+
+func NewRichMenuRepository(db *db.DB) repository.RichMenuRepository {
+	return &RichMenuRepository{db: db}
+}
+
+// richMenuColumns 一覧取得用の列（画像を除く）
+const richMenuColumns = `id, name, chat_bar_text, size, areas, selected, revision, line_rich_menu_id, is_default, image_content_type, applied_at, created_at, updated_at`
+
+// insertRichMenuRevisionSQL 直前のCTE（m）で書き込んだ行の定義を、未記録の Revision であればリビジョンとして記録する
+const insertRichMenuRevisionSQL = `
+		INSERT INTO rich_menu_revisions (rich_menu_id, revision, name, chat_bar_text, size, areas, selected, created_at)
+		SELECT m.id, m.revision, m.name, m.chat_bar_text, m.size, m.areas, m.selected, m.updated_at
+		FROM m
+		ON CONFLICT (rich_menu_id, revision) DO NOTHING
+`
+
+func (r *RichMenuRepository) Create(ctx context.Context, menu *model.RichMenu) error {
+	query := `
+		WITH m AS (
+			INSERT INTO rich_menus (id, name, chat_bar_text, size, areas, selected, revision, line_rich_menu_id, is_default, image, image_content_type, applied_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			RETURNING *
+		)` + insertRichMenuRevisionSQL
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query,
+		menu.ID,
+		menu.Name,
+		menu.ChatBarText,
+		menu.Size,
+		menu.Areas,
+		menu.Selected,
+		menu.Revision,
+		menu.LineRichMenuID,
+		menu.IsDefault,
+		menu.Image,
+		menu.ImageContentType,
+		menu.AppliedAt,
+		menu.CreatedAt,
+		menu.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create rich menu: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RichMenuRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RichMenu, error) {
+	query := `SELECT ` + richMenuColumns + `, image FROM rich_menus WHERE id = $1`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var menu model.RichMenu
+	err := sqlx.GetContext(ctx, executor, &menu, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("rich menu not found")
+		}
+		return nil, fmt.Errorf("failed to find rich menu: %w", err)
+	}
+
+	return &menu, nil
+}
+
+func (r *RichMenuRepository) List(ctx context.Context, limit, offset int) ([]*model.RichMenu, error) {
+	query := `
+		SELECT ` + richMenuColumns + `
+		FROM rich_menus
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var menus []*model.RichMenu
+	err := sqlx.SelectContext(ctx, executor, &menus, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rich menus: %w", err)
+	}
+
+	return menus, nil
+}
+
+func (r *RichMenuRepository) Update(ctx context.Context, menu *model.RichMenu) error {
+	query := `
+		WITH m AS (
+			UPDATE rich_menus
+			SET name = $2, chat_bar_text = $3, size = $4, areas = $5, selected = $6, revision = $7, line_rich_menu_id = $8, is_default = $9, image = $10, image_content_type = $11, applied_at = $12, updated_at = $13
+			WHERE id = $1
+			RETURNING *
+		), r AS (` + insertRichMenuRevisionSQL + `
+		)
+		SELECT COUNT(*) FROM m
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var updated int
+	err := sqlx.GetContext(ctx, executor, &updated, query,
+		menu.ID,
+		menu.Name,
+		menu.ChatBarText,
+		menu.Size,
+		menu.Areas,
+		menu.Selected,
+		menu.Revision,
+		menu.LineRichMenuID,
+		menu.IsDefault,
+		menu.Image,
+		menu.ImageContentType,
+		menu.AppliedAt,
+		menu.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update rich menu: %w", err)
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("rich menu not found")
+	}
+
+	return nil
+}
+
+func (r *RichMenuRepository) SetDefault(ctx context.Context, id uuid.UUID, at time.Time) error {
+	// 既定が一時的にも2つにならないよう、既定を外す行と設定する行を1文で更新する
+	query := `
+		UPDATE rich_menus
+		SET is_default = (id = $1), updated_at = $2
+		WHERE is_default OR id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to set default rich menu: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("rich menu not found")
+	}
+
+	return nil
+}
+
+func (r *RichMenuRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM rich_menus WHERE id = $1`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete rich menu: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("rich menu not found")
+	}
+
+	return nil
+}
+
+func (r *RichMenuRepository) ListRevisions(ctx context.Context, richMenuID uuid.UUID) ([]*model.RichMenuRevision, error) {
+	query := `
+		SELECT id, rich_menu_id, revision, name, chat_bar_text, size, areas, selected, created_at
+		FROM rich_menu_revisions
+		WHERE rich_menu_id = $1
+		ORDER BY revision DESC
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var revisions []*model.RichMenuRevision
+	err := sqlx.SelectContext(ctx, executor, &revisions, query, richMenuID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rich menu revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+func (r *RichMenuRepository) LinkUsers(ctx context.Context, richMenuID uuid.UUID, userIDs []string, at time.Time) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO rich_menu_links (line_user_id, rich_menu_id, linked_at)
+		SELECT line_user_id, $1, $3 FROM UNNEST($2::text[]) AS line_user_id
+		ON CONFLICT (line_user_id) DO UPDATE SET rich_menu_id = EXCLUDED.rich_menu_id, linked_at = EXCLUDED.linked_at
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query, richMenuID, pq.Array(userIDs), at)
+	if err != nil {
+		return fmt.Errorf("failed to link rich menu: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RichMenuRepository) UnlinkUsers(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query := `DELETE FROM rich_menu_links WHERE line_user_id = ANY($1::text[])`
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query, pq.Array(userIDs))
+	if err != nil {
+		return fmt.Errorf("failed to unlink rich menu: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RichMenuRepository) ListLinkedUserIDs(ctx context.Context, richMenuID uuid.UUID) ([]string, error) {
+	query := `
+		SELECT line_user_id
+		FROM rich_menu_links
+		WHERE rich_menu_id = $1
+		ORDER BY linked_at ASC, line_user_id ASC
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var userIDs []string
+	err := sqlx.SelectContext(ctx, executor, &userIDs, query, richMenuID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rich menu links: %w", err)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,189 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type TagRepository struct {
+	db *db.DB
+}
+
+func NewTagRepository(db *db.DB) repository.TagRepository {
+	return &TagRepository{db: db}
+}
+
+func (r *TagRepository) Create(ctx context.Context, tag *model.Tag) error {
+	query := `
+		INSERT INTO tags (id, name, color, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query,
+		tag.ID,
+		tag.Name,
+		tag.Color,
+		tag.CreatedAt,
+		tag.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TagRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Tag, error) {
+	query := `
+		SELECT t.id, t.name, t.color, t.created_at, t.updated_at,
+			(SELECT COUNT(*) FROM message_tags mt JOIN messages m ON m.id = mt.message_id WHERE mt.tag_id = t.id AND m.deleted_at IS NULL) AS usage_count
+		FROM tags t
+		WHERE t.id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var tag model.Tag
+	err := sqlx.GetContext(ctx, executor, &tag, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("tag not found")
+		}
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+func (r *TagRepository) FindByName(ctx context.Context, name string) (*model.Tag, error) {
+	query := `
+		SELECT id, name, color, created_at, updated_at
+		FROM tags
+		WHERE LOWER(name) = LOWER($1)
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var tag model.Tag
+	err := sqlx.GetContext(ctx, executor, &tag, query, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("tag not found")
+		}
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+func (r *TagRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Tag, error) {
+	query := `
+		SELECT id, name, color, created_at, updated_at
+		FROM tags
+		WHERE id = ANY($1::uuid[])
+		ORDER BY name ASC
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var tags []*model.Tag
+	err := sqlx.SelectContext(ctx, executor, &tags, query, pq.Array(uuidStrings(ids)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepository) List(ctx context.Context, limit, offset int) ([]*model.Tag, error) {
+	query := `
+		SELECT t.id, t.name, t.color, t.created_at, t.updated_at, COUNT(m.id) AS usage_count
+		FROM tags t
+		LEFT JOIN message_tags mt ON mt.tag_id = t.id
+		LEFT JOIN messages m ON m.id = mt.message_id AND m.deleted_at IS NULL
+		GROUP BY t.id
+		ORDER BY t.name ASC
+		LIMIT $1 OFFSET $2
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var tags []*model.Tag
+	err := sqlx.SelectContext(ctx, executor, &tags, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepository) Update(ctx context.Context, tag *model.Tag) error {
+	query := `
+		UPDATE tags
+		SET name = $2, color = $3, updated_at = $4
+		WHERE id = $1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query,
+		tag.ID,
+		tag.Name,
+		tag.Color,
+		tag.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("tag not found")
+	}
+
+	return nil
+}
+
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM tags WHERE id = $1`
+
+	executor := db.GetExecutor(ctx, r.db)
+	result, err := executor.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("tag not found")
+	}
+
+	return nil
+}
+
+// uuidStrings ANY($n) に渡すためUUIDを文字列の配列にする
+func uuidStrings(ids []uuid.UUID) []string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values
+}
@@ -0,0 +1,61 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+)
+
+type WorkspaceSettingsRepository struct {
+	db *db.DB
+}
+
+func NewWorkspaceSettingsRepository(db *db.DB) repository.WorkspaceSettingsRepository {
+	return &WorkspaceSettingsRepository{db: db}
+}
+
+func (r *WorkspaceSettingsRepository) Get(ctx context.Context) (*model.WorkspaceSettings, error) {
+	query := `
+		SELECT default_timezone, updated_at
+		FROM workspace_settings
+		WHERE id = 1
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+
+	var settings model.WorkspaceSettings
+	err := sqlx.GetContext(ctx, executor, &settings, query)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return model.NewWorkspaceSettings(), nil
+		}
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+
+	return &settings, nil
+}
+
+func (r *WorkspaceSettingsRepository) Save(ctx context.Context, settings *model.WorkspaceSettings) error {
+	query := `
+		INSERT INTO workspace_settings (id, default_timezone, updated_at)
+		VALUES (1, $1, $2)
+		ON CONFLICT (id) DO UPDATE SET default_timezone = EXCLUDED.default_timezone, updated_at = EXCLUDED.updated_at
+	`
+
+	executor := db.GetExecutor(ctx, r.db)
+	_, err := executor.ExecContext(ctx, query,
+		settings.DefaultTimezone,
+		settings.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to save workspace settings: %w", err)
+	}
+
+	return nil
+}
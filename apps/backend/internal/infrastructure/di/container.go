@@ -4,7 +4,16 @@ import (
 	"log"
 	"sync"
 
+	"vt-link/backend/internal/application/autoreply"
+	"vt-link/backend/internal/application/follower"
 	"vt-link/backend/internal/application/message"
+	"vt-link/backend/internal/application/messagetemplate"
+	"vt-link/backend/internal/application/recurrence"
+	"vt-link/backend/internal/application/richmenu"
+	"vt-link/backend/internal/application/tag"
+	"vt-link/backend/internal/application/webhook"
+	"vt-link/backend/internal/application/workspace"
+	"vt-link/backend/internal/domain/model"
 	"vt-link/backend/internal/infrastructure/db"
 	"vt-link/backend/internal/infrastructure/db/pg"
 	"vt-link/backend/internal/infrastructure/external"
@@ -12,8 +21,16 @@ import (
 )
 
 type Container struct {
-	MessageUsecase message.Usecase
-	DB             *db.DB
+	AutoReplyUsecase       autoreply.Usecase
+	FollowerUsecase        follower.Usecase
+	MessageUsecase         message.Usecase
+	MessageTemplateUsecase messagetemplate.Usecase
+	RecurrenceUsecase      recurrence.Usecase
+	RichMenuUsecase        richmenu.Usecase
+	TagUsecase             tag.Usecase
+	WebhookUsecase         webhook.Usecase
+	WorkspaceUsecase       workspace.Usecase
+	DB                     *db.DB
 }
 
 var (
@@ -42,6 +59,14 @@ func newContainer() (*Container, error) {
 
 	// Repository
 	messageRepo := pg.NewMessageRepository(database)
+	templateRepo := pg.NewMessageTemplateRepository(database)
+	tagRepo := pg.NewTagRepository(database)
+	scheduleRepo := pg.NewRecurringScheduleRepository(database)
+	settingsRepo := pg.NewWorkspaceSettingsRepository(database)
+	batchRepo := pg.NewDeliveryBatchRepository(database)
+	followerRepo := pg.NewFollowerRepository(database)
+	autoReplyRuleRepo := pg.NewAutoReplyRuleRepository(database)
+	richMenuRepo := pg.NewRichMenuRepository(database)
 
 	// Transaction Manager
 	txManager := db.NewTxManager(database)
@@ -50,6 +75,9 @@ func newContainer() (*Container, error) {
 	pusher := external.NewLinePusher()
 	// 開発時はDummyPusherを使用する場合
 	// pusher := external.NewDummyPusher()
+	webhookParser := external.NewLineWebhookParser()
+	richMenuClient := external.NewLineRichMenuClient()
+	profileClient := external.NewLineProfileClient()
 
 	// Clock
 	clock := clock.NewRealClock()
@@ -57,13 +85,67 @@ func newContainer() (*Container, error) {
 	// Usecase
 	messageUsecase := message.NewInteractor(
 		messageRepo,
+		templateRepo,
+		tagRepo,
+		scheduleRepo,
+		settingsRepo,
+		batchRepo,
 		txManager,
 		pusher,
 		clock,
 	)
+	messageTemplateUsecase := messagetemplate.NewInteractor(
+		templateRepo,
+		txManager,
+	)
+	recurrenceUsecase := recurrence.NewInteractor(
+		scheduleRepo,
+		messageRepo,
+		settingsRepo,
+		txManager,
+		clock,
+	)
+	tagUsecase := tag.NewInteractor(
+		tagRepo,
+		txManager,
+	)
+	followerUsecase := follower.NewInteractor(
+		followerRepo,
+		profileClient,
+		clock,
+	)
+	autoReplyUsecase := autoreply.NewInteractor(
+		autoReplyRuleRepo,
+		settingsRepo,
+		pusher,
+		clock,
+	)
+	richMenuUsecase := richmenu.NewInteractor(
+		richMenuRepo,
+		richMenuClient,
+		clock,
+	)
+	// Webhookのイベント種別ごとの処理はここで登録する
+	webhookUsecase := webhook.NewInteractor(
+		webhookParser,
+		webhook.Handlers{
+			model.WebhookEventFollow:   {webhook.EventHandlerFunc(followerUsecase.HandleFollowEvent)},
+			model.WebhookEventUnfollow: {webhook.EventHandlerFunc(followerUsecase.HandleUnfollowEvent)},
+			model.WebhookEventMessage:  {webhook.EventHandlerFunc(autoReplyUsecase.HandleMessageEvent)},
+		},
+	)
+	workspaceUsecase := workspace.NewInteractor(settingsRepo)
 
 	return &Container{
-		MessageUsecase: messageUsecase,
-		DB:             database,
+		AutoReplyUsecase:       autoReplyUsecase,
+		FollowerUsecase:        followerUsecase,
+		MessageUsecase:         messageUsecase,
+		MessageTemplateUsecase: messageTemplateUsecase,
+		RecurrenceUsecase:      recurrenceUsecase,
+		RichMenuUsecase:        richMenuUsecase,
+		TagUsecase:             tagUsecase,
+		WebhookUsecase:         webhookUsecase,
+		WorkspaceUsecase:       workspaceUsecase,
+		DB:                     database,
 	}, nil
 }
@@ -0,0 +1,157 @@
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type LineText struct {
+	Type   string      `json:"type"`
+	Text   string      `json:"text"`
+	Emojis []LineEmoji `json:"emojis,omitempty"`
+}
+
+type LineEmoji struct {
+	Index     int    `json:"index"`
+	ProductID string `json:"productId"`
+	EmojiID   string `json:"emojiId"`
+}
+
+type LineImage struct {
+	Type               string `json:"type"`
+	OriginalContentURL string `json:"originalContentUrl"`
+	PreviewImageURL    string `json:"previewImageUrl"`
+}
+
+type LineVideo struct {
+	Type               string `json:"type"`
+	OriginalContentURL string `json:"originalContentUrl"`
+	PreviewImageURL    string `json:"previewImageUrl"`
+	TrackingID         string `json:"trackingId,omitempty"`
+}
+
+type LineAudio struct {
+	Type               string `json:"type"`
+	OriginalContentURL string `json:"originalContentUrl"`
+	Duration           int64  `json:"duration"`
+}
+
+type LineSticker struct {
+	Type      string `json:"type"`
+	PackageID string `json:"packageId"`
+	StickerID string `json:"stickerId"`
+}
+
+type LineLocation struct {
+	Type      string  `json:"type"`
+	Title     string  `json:"title"`
+	Address   string  `json:"address"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type LineFlex struct {
+	Type     string          `json:"type"`
+	AltText  string          `json:"altText"`
+	Contents json.RawMessage `json:"contents"`
+}
+
+// BuildLineMessages MessageをLINE Messaging APIのメッセージオブジェクトに変換
+func BuildLineMessages(message *model.Message) ([]interface{}, error) {
+	if err := message.Validate(); err != nil {
+		return nil, err
+	}
+
+	contents := message.Contents()
+	if len(contents) == 0 {
+		// リッチコンテンツがなければタイトルと本文をテキストで送信
+		text := fmt.Sprintf("%s\n\n%s", message.Title, message.Body)
+		return attachQuickReply([]interface{}{LineText{Type: "text", Text: text}}, message.QuickReply), nil
+	}
+	// パートは順番どおり1回のPushで送信する（クイックリプライは最後のパートに付く）
+	objects := make([]interface{}, 0, len(contents))
+	for i := range contents {
+		object, err := buildLineContent(&contents[i])
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return attachQuickReply(objects, message.QuickReply), nil
+}
+
+// BuildLineContents メッセージ内容を順番どおりLINEのメッセージオブジェクトに変換（応答メッセージなどタイトルを伴わない送信用）
+func BuildLineContents(parts model.MessageParts) ([]interface{}, error) {
+	if err := parts.Validate(); err != nil {
+		return nil, err
+	}
+	objects := make([]interface{}, 0, len(parts))
+	for i := range parts {
+		object, err := buildLineContent(&parts[i])
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+func buildLineContent(content *model.MessageContent) (interface{}, error) {
+	if err := content.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch content.Type {
+	case model.ContentTypeText:
+		text := LineText{Type: "text", Text: content.Text.Text}
+		for _, emoji := range content.Text.Emojis {
+			text.Emojis = append(text.Emojis, LineEmoji{Index: emoji.Index, ProductID: emoji.ProductID, EmojiID: emoji.EmojiID})
+		}
+		return text, nil
+	case model.ContentTypeImage:
+		return LineImage{
+			Type:               "image",
+			OriginalContentURL: content.Image.OriginalContentURL,
+			PreviewImageURL:    content.Image.PreviewImageURL,
+		}, nil
+	case model.ContentTypeVideo:
+		return LineVideo{
+			Type:               "video",
+			OriginalContentURL: content.Video.OriginalContentURL,
+			PreviewImageURL:    content.Video.PreviewImageURL,
+			TrackingID:         content.Video.TrackingID,
+		}, nil
+	case model.ContentTypeAudio:
+		return LineAudio{
+			Type:               "audio",
+			OriginalContentURL: content.Audio.OriginalContentURL,
+			Duration:           content.Audio.Duration,
+		}, nil
+	case model.ContentTypeSticker:
+		return LineSticker{
+			Type:      "sticker",
+			PackageID: content.Sticker.PackageID,
+			StickerID: content.Sticker.StickerID,
+		}, nil
+	case model.ContentTypeLocation:
+		return LineLocation{
+			Type:      "location",
+			Title:     content.Location.Title,
+			Address:   content.Location.Address,
+			Latitude:  content.Location.Latitude,
+			Longitude: content.Location.Longitude,
+		}, nil
+	case model.ContentTypeFlex:
+		return LineFlex{
+			Type:     "flex",
+			AltText:  content.Flex.AltText,
+			Contents: content.Flex.Contents,
+		}, nil
+	case model.ContentTypeTemplate:
+		return buildLineTemplate(content.Template), nil
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", content.Type)
+	}
+}
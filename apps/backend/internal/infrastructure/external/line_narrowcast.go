@@ -0,0 +1,119 @@
+package external
+
+import (
+	"vt-link/backend/internal/domain/model"
+)
+
+// LineNarrowcastMessage 絞り込み配信のリクエストボディ
+type LineNarrowcastMessage struct {
+	Messages  []interface{}         `json:"messages"`
+	Recipient *LineRecipient        `json:"recipient,omitempty"`
+	Filter    *LineNarrowcastFilter `json:"filter,omitempty"`
+	Limit     *LineNarrowcastLimit  `json:"limit,omitempty"`
+}
+
+// LineRecipient 受信者オブジェクト（オーディエンス、または複数条件を組み合わせる演算子）
+type LineRecipient struct {
+	Type            string          `json:"type"`
+	AudienceGroupID int64           `json:"audienceGroupId,omitempty"`
+	Or              []LineRecipient `json:"or,omitempty"`
+}
+
+type LineNarrowcastFilter struct {
+	Demographic *LineDemographicFilter `json:"demographic"`
+}
+
+// LineDemographicFilter 属性情報フィルターオブジェクト（性別・年齢・地域、または AND 演算子）
+type LineDemographicFilter struct {
+	Type  string                  `json:"type"`
+	OneOf []string                `json:"oneOf,omitempty"`
+	Gte   string                  `json:"gte,omitempty"`
+	Lt    string                  `json:"lt,omitempty"`
+	And   []LineDemographicFilter `json:"and,omitempty"`
+}
+
+type LineNarrowcastLimit struct {
+	Max int `json:"max"`
+}
+
+// LineNarrowcastProgress 絞り込み配信の進捗（/v2/bot/message/progress/narrowcast）
+type LineNarrowcastProgress struct {
+	Phase             string `json:"phase"`
+	SuccessCount      int    `json:"successCount"`
+	FailureCount      int    `json:"failureCount"`
+	TargetCount       int    `json:"targetCount"`
+	FailedDescription string `json:"failedDescription"`
+}
+
+// BuildNarrowcastMessage メッセージと絞り込み条件から絞り込み配信のリクエストを組み立てる
+func BuildNarrowcastMessage(message *model.Message) (*LineNarrowcastMessage, error) {
+	if message.Narrowcast == nil {
+		return nil, model.ErrInvalidContent
+	}
+	messages, err := BuildLineMessages(message)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := message.Narrowcast
+	request := &LineNarrowcastMessage{
+		Messages:  messages,
+		Recipient: BuildLineRecipient(filter.AudienceGroupIDs),
+	}
+	if demographic := BuildLineDemographicFilter(filter); demographic != nil {
+		request.Filter = &LineNarrowcastFilter{Demographic: demographic}
+	}
+	if filter.Max > 0 {
+		request.Limit = &LineNarrowcastLimit{Max: filter.Max}
+	}
+	return request, nil
+}
+
+// BuildLineRecipient オーディエンスのいずれかに含まれる友だちを表す受信者オブジェクト（指定なしの場合は nil）
+func BuildLineRecipient(audienceGroupIDs []int64) *LineRecipient {
+	audiences := make([]LineRecipient, len(audienceGroupIDs))
+	for i, id := range audienceGroupIDs {
+		audiences[i] = LineRecipient{Type: "audience", AudienceGroupID: id}
+	}
+	switch len(audiences) {
+	case 0:
+		return nil
+	case 1:
+		return &audiences[0]
+	default:
+		return &LineRecipient{Type: "operator", Or: audiences}
+	}
+}
+
+// BuildLineDemographicFilter 性別・年齢・地域の条件を全て満たす友だちを表すフィルター（条件なしの場合は nil）
+func BuildLineDemographicFilter(filter *model.NarrowcastFilter) *LineDemographicFilter {
+	var conditions []LineDemographicFilter
+	if len(filter.Genders) > 0 {
+		conditions = append(conditions, LineDemographicFilter{Type: "gender", OneOf: filter.Genders})
+	}
+	if filter.Ages != nil {
+		conditions = append(conditions, LineDemographicFilter{Type: "age", Gte: filter.Ages.Gte, Lt: filter.Ages.Lt})
+	}
+	if len(filter.Areas) > 0 {
+		conditions = append(conditions, LineDemographicFilter{Type: "area", OneOf: filter.Areas})
+	}
+	switch len(conditions) {
+	case 0:
+		return nil
+	case 1:
+		return &conditions[0]
+	default:
+		return &LineDemographicFilter{Type: "operator", And: conditions}
+	}
+}
+
+// toModel LINEの進捗をドメインの進捗に変換
+func (p *LineNarrowcastProgress) toModel() *model.NarrowcastProgress {
+	return &model.NarrowcastProgress{
+		Phase:             model.NarrowcastPhase(p.Phase),
+		SuccessCount:      p.SuccessCount,
+		FailureCount:      p.FailureCount,
+		TargetCount:       p.TargetCount,
+		FailedDescription: p.FailedDescription,
+	}
+}
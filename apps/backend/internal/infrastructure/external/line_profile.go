@@ -0,0 +1,67 @@
+package external
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/service"
+)
+
+const lineProfileEndpoint = "https://api.line.me/v2/bot/profile/"
+
+// LineProfileClient LINEのプロフィール取得APIのクライアント
+type LineProfileClient struct {
+	channelAccessToken string
+	channelID          string
+	httpClient         *http.Client
+}
+
+func NewLineProfileClient() service.ProfileClient {
+	return &LineProfileClient{
+		channelAccessToken: os.Getenv("LINE_ACCESS_TOKEN"),
+		channelID:          os.Getenv("LINE_CHANNEL_ID"),
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (c *LineProfileClient) GetProfile(ctx context.Context, userID string) (*model.LineProfile, error) {
+	if c.channelAccessToken == "" || c.channelID == "" {
+		return &model.LineProfile{UserID: userID}, nil
+	}
+
+	var profile struct {
+		UserID        string `json:"userId"`
+		DisplayName   string `json:"displayName"`
+		PictureURL    string `json:"pictureUrl"`
+		StatusMessage string `json:"statusMessage"`
+		Language      string `json:"language"`
+	}
+	if err := lineGetJSON(ctx, c.httpClient, c.channelAccessToken, lineProfileEndpoint+url.PathEscape(userID), &profile); err != nil {
+		return nil, err
+	}
+
+	return &model.LineProfile{
+		UserID:        profile.UserID,
+		DisplayName:   profile.DisplayName,
+		PictureURL:    profile.PictureURL,
+		StatusMessage: profile.StatusMessage,
+		Language:      profile.Language,
+	}, nil
+}
+
+// DummyProfileClient テスト・開発用のダミー実装
+type DummyProfileClient struct{}
+
+func NewDummyProfileClient() service.ProfileClient {
+	return &DummyProfileClient{}
+}
+
+func (c *DummyProfileClient) GetProfile(ctx context.Context, userID string) (*model.LineProfile, error) {
+	return &model.LineProfile{UserID: userID}, nil
+}
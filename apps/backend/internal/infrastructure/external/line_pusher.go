@@ -8,9 +8,12 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
 
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
 	"vt-link/backend/internal/domain/service"
 )
 
@@ -21,15 +24,42 @@ type LinePusher struct {
 }
 
 type LineMessage struct {
-	To       string     `json:"to"`
-	Messages []LineText `json:"messages"`
+	To       string        `json:"to"`
+	Messages []interface{} `json:"messages"`
 }
 
-type LineText struct {
-	Type string `json:"type"`
-	Text string `json:"text"`
+// LineMulticastMessage マルチキャストのリクエストボディ
+type LineMulticastMessage struct {
+	To       []string      `json:"to"`
+	Messages []interface{} `json:"messages"`
 }
 
+// LineReplyMessage 応答メッセージのリクエストボディ
+type LineReplyMessage struct {
+	ReplyToken string        `json:"replyToken"`
+	Messages   []interface{} `json:"messages"`
+}
+
+// LineBroadcastMessage ブロードキャストのリクエストボディ
+type LineBroadcastMessage struct {
+	Messages []interface{} `json:"messages"`
+}
+
+const (
+	linePushEndpoint               = "https://api.line.me/v2/bot/message/push"
+	lineReplyEndpoint              = "https://api.line.me/v2/bot/message/reply"
+	lineMulticastEndpoint          = "https://api.line.me/v2/bot/message/multicast"
+	lineBroadcastEndpoint          = "https://api.line.me/v2/bot/message/broadcast"
+	lineNarrowcastEndpoint         = "https://api.line.me/v2/bot/message/narrowcast"
+	lineNarrowcastProgressEndpoint = "https://api.line.me/v2/bot/message/progress/narrowcast"
+	lineQuotaEndpoint              = "https://api.line.me/v2/bot/message/quota"
+	lineQuotaConsumptionEndpoint   = "https://api.line.me/v2/bot/message/quota/consumption"
+	lineFollowersInsightEndpoint   = "https://api.line.me/v2/bot/insight/followers"
+)
+
+// lineInsightLocation 統計APIの日付はUTC+9で指定する
+var lineInsightLocation = time.FixedZone("UTC+9", 9*60*60)
+
 func NewLinePusher() service.Pusher {
 	return &LinePusher{
 		channelAccessToken: os.Getenv("LINE_ACCESS_TOKEN"),
@@ -41,6 +71,23 @@ func NewLinePusher() service.Pusher {
 }
 
 func (p *LinePusher) PushText(ctx context.Context, text string) error {
+	return p.push(ctx, []interface{}{
+		LineText{
+			Type: "text",
+			Text: text,
+		},
+	})
+}
+
+func (p *LinePusher) PushMessage(ctx context.Context, message *model.Message) error {
+	messages, err := BuildLineMessages(message)
+	if err != nil {
+		return fmt.Errorf("failed to build LINE messages: %w", err)
+	}
+	return p.push(ctx, messages)
+}
+
+func (p *LinePusher) push(ctx context.Context, messages []interface{}) error {
 	if p.channelAccessToken == "" || p.channelID == "" {
 		log.Println("LINE credentials not configured, skipping push")
 		return nil // 本番では環境変数未設定時はスキップ
@@ -55,51 +102,203 @@ func (p *LinePusher) PushText(ctx context.Context, text string) error {
 	}
 
 	message := LineMessage{
-		To: targetUserID,
-		Messages: []LineText{
-			{
-				Type: "text",
-				Text: text,
-			},
-		},
+		To:       targetUserID,
+		Messages: messages,
+	}
+
+	_, err := p.sendMessage(ctx, linePushEndpoint, message, "")
+	return err
+}
+
+func (p *LinePusher) Multicast(ctx context.Context, message *model.Message, to model.Recipients, retryKey uuid.UUID) (string, error) {
+	if len(to) > model.MulticastMaxRecipients {
+		return "", fmt.Errorf("multicast supports at most %d recipients, got %d", model.MulticastMaxRecipients, len(to))
+	}
+	if p.channelAccessToken == "" || p.channelID == "" {
+		log.Println("LINE credentials not configured, skipping multicast")
+		return "", nil
+	}
+
+	messages, err := BuildLineMessages(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to build LINE messages: %w", err)
+	}
+
+	return p.sendMessage(ctx, lineMulticastEndpoint, LineMulticastMessage{To: to, Messages: messages}, retryKey.String())
+}
+
+func (p *LinePusher) Reply(ctx context.Context, replyToken string, replies model.MessageParts) error {
+	if p.channelAccessToken == "" || p.channelID == "" {
+		log.Println("LINE credentials not configured, skipping reply")
+		return nil
 	}
 
-	return p.sendMessage(ctx, message)
+	messages, err := BuildLineContents(replies)
+	if err != nil {
+		return fmt.Errorf("failed to build LINE messages: %w", err)
+	}
+
+	// 応答トークンは1回しか使えないため再送キーは付けない
+	_, err = p.sendMessage(ctx, lineReplyEndpoint, LineReplyMessage{ReplyToken: replyToken, Messages: messages}, "")
+	return err
 }
 
-func (p *LinePusher) PushMessage(ctx context.Context, title, body string) error {
-	text := fmt.Sprintf("%s\n\n%s", title, body)
-	return p.PushText(ctx, text)
+func (p *LinePusher) Broadcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error) {
+	if p.channelAccessToken == "" || p.channelID == "" {
+		log.Println("LINE credentials not configured, skipping broadcast")
+		return "", nil
+	}
+
+	messages, err := BuildLineMessages(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to build LINE messages: %w", err)
+	}
+
+	return p.sendMessage(ctx, lineBroadcastEndpoint, LineBroadcastMessage{Messages: messages}, retryKey.String())
 }
 
-func (p *LinePusher) sendMessage(ctx context.Context, message LineMessage) error {
-	jsonData, err := json.Marshal(message)
+func (p *LinePusher) Narrowcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error) {
+	if p.channelAccessToken == "" || p.channelID == "" {
+		log.Println("LINE credentials not configured, skipping narrowcast")
+		return "", nil
+	}
+
+	request, err := BuildNarrowcastMessage(message)
 	if err != nil {
-		return fmt.Errorf("failed to marshal message: %w", err)
+		return "", fmt.Errorf("failed to build LINE narrowcast: %w", err)
+	}
+
+	return p.sendMessage(ctx, lineNarrowcastEndpoint, request, retryKey.String())
+}
+
+func (p *LinePusher) GetNarrowcastProgress(ctx context.Context, requestID string) (*model.NarrowcastProgress, error) {
+	// 認証情報がない場合は送信もスキップしているため、完了として扱う
+	if p.channelAccessToken == "" || p.channelID == "" || requestID == "" {
+		return &model.NarrowcastProgress{Phase: model.NarrowcastPhaseSucceeded}, nil
+	}
+
+	var progress LineNarrowcastProgress
+	if err := p.getJSON(ctx, lineNarrowcastProgressEndpoint+"?requestId="+url.QueryEscape(requestID), &progress); err != nil {
+		return nil, err
+	}
+	return progress.toModel(), nil
+}
+
+func (p *LinePusher) GetQuota(ctx context.Context) (*model.MessageQuota, error) {
+	if p.channelAccessToken == "" || p.channelID == "" {
+		return &model.MessageQuota{}, nil
+	}
+
+	var quota struct {
+		Type  string `json:"type"`
+		Value int    `json:"value"`
+	}
+	if err := p.getJSON(ctx, lineQuotaEndpoint, &quota); err != nil {
+		return nil, err
+	}
+	var consumption struct {
+		TotalUsage int `json:"totalUsage"`
 	}
+	if err := p.getJSON(ctx, lineQuotaConsumptionEndpoint, &consumption); err != nil {
+		return nil, err
+	}
+
+	return &model.MessageQuota{
+		Limited: quota.Type == "limited",
+		Limit:   quota.Value,
+		Used:    consumption.TotalUsage,
+	}, nil
+}
+
+func (p *LinePusher) CountReachableFollowers(ctx context.Context) (int, error) {
+	if p.channelAccessToken == "" || p.channelID == "" {
+		return 0, nil
+	}
+
+	// 当日分は集計されていないため前日の値を使う
+	date := time.Now().In(lineInsightLocation).AddDate(0, 0, -1).Format("20060102")
+	var insight struct {
+		Status          string `json:"status"`
+		TargetedReaches int    `json:"targetedReaches"`
+	}
+	if err := p.getJSON(ctx, lineFollowersInsightEndpoint+"?date="+date, &insight); err != nil {
+		return 0, err
+	}
+	if insight.Status != "ready" {
+		return 0, fmt.Errorf("follower statistics for %s are not ready (status %s)", date, insight.Status)
+	}
+	return insight.TargetedReaches, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.line.me/v2/bot/message/push", bytes.NewBuffer(jsonData))
+// getJSON Messaging API の GET エンドポイントのレスポンスを out に読み込む
+func (p *LinePusher) getJSON(ctx context.Context, endpoint string, out interface{}) error {
+	return lineGetJSON(ctx, p.httpClient, p.channelAccessToken, endpoint, out)
+}
+
+// lineGetJSON チャネルアクセストークンで Messaging API の GET エンドポイントを呼び、レスポンスを out に読み込む
+func lineGetJSON(ctx context.Context, httpClient *http.Client, channelAccessToken, endpoint string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
+	req.Header.Set("Authorization", "Bearer "+channelAccessToken)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		log.Printf("LINE API error: status=%d, body=%s", resp.StatusCode, string(body))
+		return fmt.Errorf("LINE API error: status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode LINE API response: %w", err)
+	}
+	return nil
+}
+
+// sendMessage Messaging API にリクエストを送り、受け付けられたリクエストのIDを返す。
+// retryKey を指定した場合、同じキーで既に受け付けられていれば（409）その時のリクエストIDを返す
+func (p *LinePusher) sendMessage(ctx context.Context, endpoint string, payload interface{}, retryKey string) (string, error) {
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+p.channelAccessToken)
+	if retryKey != "" {
+		req.Header.Set("X-Line-Retry-Key", retryKey)
+	}
 
 	resp, err := p.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return "", fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode == http.StatusConflict && resp.Header.Get("X-Line-Accepted-Request-Id") != "" {
+		log.Printf("LINE message already accepted for retry key %s", retryKey)
+		return resp.Header.Get("X-Line-Accepted-Request-Id"), nil
+	}
+	// 絞り込み配信は 202 Accepted を返す
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
 		body, _ := io.ReadAll(resp.Body)
 		log.Printf("LINE API error: status=%d, body=%s", resp.StatusCode, string(body))
-		return fmt.Errorf("LINE API error: status %d", resp.StatusCode)
+		return "", fmt.Errorf("LINE API error: status %d", resp.StatusCode)
 	}
 
 	log.Printf("Successfully sent LINE message")
-	return nil
+	return resp.Header.Get("X-Line-Request-Id"), nil
 }
 
 // DummyPusher テスト・開発用のダミー実装
@@ -114,7 +313,46 @@ func (p *DummyPusher) PushText(ctx context.Context, text string) error {
 	return nil
 }
 
-func (p *DummyPusher) PushMessage(ctx context.Context, title, body string) error {
-	log.Printf("[DUMMY] Push message - Title: %s, Body: %s", title, body)
+func (p *DummyPusher) PushMessage(ctx context.Context, message *model.Message) error {
+	contentTypes := []model.ContentType{model.ContentTypeText}
+	if contents := message.Contents(); len(contents) > 0 {
+		contentTypes = contentTypes[:0]
+		for _, content := range contents {
+			contentTypes = append(contentTypes, content.Type)
+		}
+	}
+	log.Printf("[DUMMY] Push message - Title: %s, Types: %v", message.Title, contentTypes)
+	return nil
+}
+
+func (p *DummyPusher) Multicast(ctx context.Context, message *model.Message, to model.Recipients, retryKey uuid.UUID) (string, error) {
+	log.Printf("[DUMMY] Multicast message - Title: %s, Recipients: %d", message.Title, len(to))
+	return "dummy-" + retryKey.String(), nil
+}
+
+func (p *DummyPusher) Reply(ctx context.Context, replyToken string, replies model.MessageParts) error {
+	log.Printf("[DUMMY] Reply - Token: %s, Messages: %d", replyToken, len(replies))
 	return nil
 }
+
+func (p *DummyPusher) Broadcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error) {
+	log.Printf("[DUMMY] Broadcast message - Title: %s", message.Title)
+	return "dummy-" + retryKey.String(), nil
+}
+
+func (p *DummyPusher) GetQuota(ctx context.Context) (*model.MessageQuota, error) {
+	return &model.MessageQuota{}, nil
+}
+
+func (p *DummyPusher) CountReachableFollowers(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (p *DummyPusher) Narrowcast(ctx context.Context, message *model.Message, retryKey uuid.UUID) (string, error) {
+	log.Printf("[DUMMY] Narrowcast message - Title: %s, Filter: %+v", message.Title, message.Narrowcast)
+	return "dummy-" + retryKey.String(), nil
+}
+
+func (p *DummyPusher) GetNarrowcastProgress(ctx context.Context, requestID string) (*model.NarrowcastProgress, error) {
+	return &model.NarrowcastProgress{Phase: model.NarrowcastPhaseSucceeded}, nil
+}
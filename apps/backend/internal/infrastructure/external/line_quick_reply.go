@@ -0,0 +1,63 @@
+package external
+
+import (
+	"encoding/json"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type LineQuickReply struct {
+	Items []LineQuickReplyItem `json:"items"`
+}
+
+type LineQuickReplyItem struct {
+	Type     string     `json:"type"`
+	ImageURL string     `json:"imageUrl,omitempty"`
+	Action   LineAction `json:"action"`
+}
+
+// lineObjectWithQuickReply 任意のメッセージオブジェクトに quickReply プロパティを付与する
+type lineObjectWithQuickReply struct {
+	object     interface{}
+	quickReply LineQuickReply
+}
+
+func (o lineObjectWithQuickReply) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(o.object)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	quickReply, err := json.Marshal(o.quickReply)
+	if err != nil {
+		return nil, err
+	}
+	fields["quickReply"] = quickReply
+
+	return json.Marshal(fields)
+}
+
+// attachQuickReply クイックリプライは最後のメッセージオブジェクトに付与する（LINEは最後の吹き出しのみ表示する）
+func attachQuickReply(objects []interface{}, items model.QuickReplyItems) []interface{} {
+	if len(items) == 0 || len(objects) == 0 {
+		return objects
+	}
+
+	quickReply := LineQuickReply{Items: make([]LineQuickReplyItem, 0, len(items))}
+	for _, item := range items {
+		quickReply.Items = append(quickReply.Items, LineQuickReplyItem{
+			Type:     "action",
+			ImageURL: item.ImageURL,
+			Action:   BuildLineAction(item.Action),
+		})
+	}
+
+	last := len(objects) - 1
+	objects[last] = lineObjectWithQuickReply{object: objects[last], quickReply: quickReply}
+	return objects
+}
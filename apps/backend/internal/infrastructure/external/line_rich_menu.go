@@ -0,0 +1,257 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/service"
+)
+
+const (
+	lineRichMenuEndpoint        = "https://api.line.me/v2/bot/richmenu"
+	lineRichMenuContentEndpoint = "https://api-data.line.me/v2/bot/richmenu/%s/content"
+	lineDefaultRichMenuEndpoint = "https://api.line.me/v2/bot/user/all/richmenu/"
+	lineRichMenuBulkLink        = "https://api.line.me/v2/bot/richmenu/bulk/link"
+	lineRichMenuBulkUnlink      = "https://api.line.me/v2/bot/richmenu/bulk/unlink"
+)
+
+// LineRichMenuClient LINEのリッチメニューAPIのクライアント
+type LineRichMenuClient struct {
+	channelAccessToken string
+	channelID          string
+	httpClient         *http.Client
+}
+
+func NewLineRichMenuClient() service.RichMenuClient {
+	return &LineRichMenuClient{
+		channelAccessToken: os.Getenv("LINE_ACCESS_TOKEN"),
+		channelID:          os.Getenv("LINE_CHANNEL_ID"),
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// LineRichMenu リッチメニュー作成のリクエストボディ
+type LineRichMenu struct {
+	Size        LineRichMenuSize   `json:"size"`
+	Selected    bool               `json:"selected"`
+	Name        string             `json:"name"`
+	ChatBarText string             `json:"chatBarText"`
+	Areas       []LineRichMenuArea `json:"areas"`
+}
+
+type LineRichMenuSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type LineRichMenuArea struct {
+	Bounds LineRichMenuBounds `json:"bounds"`
+	Action LineAction         `json:"action"`
+}
+
+type LineRichMenuBounds struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// LineRichMenuBulkLink 一括リンクのリクエストボディ
+type LineRichMenuBulkLink struct {
+	RichMenuID string   `json:"richMenuId"`
+	UserIDs    []string `json:"userIds"`
+}
+
+// LineRichMenuBulkUnlink 一括リンク解除のリクエストボディ
+type LineRichMenuBulkUnlink struct {
+	UserIDs []string `json:"userIds"`
+}
+
+// BuildLineRichMenu リッチメニューの定義をLINEのリクエストボディに変換
+func BuildLineRichMenu(definition *model.RichMenuDefinition) LineRichMenu {
+	areas := make([]LineRichMenuArea, 0, len(definition.Areas))
+	for _, area := range definition.Areas {
+		areas = append(areas, LineRichMenuArea{
+			Bounds: LineRichMenuBounds{
+				X:      area.Bounds.X,
+				Y:      area.Bounds.Y,
+				Width:  area.Bounds.Width,
+				Height: area.Bounds.Height,
+			},
+			Action: BuildLineAction(area.Action),
+		})
+	}
+	return LineRichMenu{
+		Size:        LineRichMenuSize{Width: definition.Size.Width, Height: definition.Size.Height},
+		Selected:    definition.Selected,
+		Name:        definition.Name,
+		ChatBarText: definition.ChatBarText,
+		Areas:       areas,
+	}
+}
+
+func (c *LineRichMenuClient) CreateRichMenu(ctx context.Context, definition *model.RichMenuDefinition) (string, error) {
+	if c.channelAccessToken == "" || c.channelID == "" {
+		log.Println("LINE credentials not configured, skipping rich menu creation")
+		return "", nil
+	}
+
+	body, err := json.Marshal(BuildLineRichMenu(definition))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal rich menu: %w", err)
+	}
+
+	var created struct {
+		RichMenuID string `json:"richMenuId"`
+	}
+	if err := c.richMenuRequest(ctx, "POST", lineRichMenuEndpoint, "application/json", body, &created); err != nil {
+		return "", err
+	}
+	return created.RichMenuID, nil
+}
+
+func (c *LineRichMenuClient) UploadRichMenuImage(ctx context.Context, richMenuID, contentType string, image []byte) error {
+	if c.channelAccessToken == "" || c.channelID == "" {
+		log.Println("LINE credentials not configured, skipping rich menu image upload")
+		return nil
+	}
+
+	endpoint := fmt.Sprintf(lineRichMenuContentEndpoint, url.PathEscape(richMenuID))
+	return c.richMenuRequest(ctx, "POST", endpoint, contentType, image, nil)
+}
+
+func (c *LineRichMenuClient) SetDefaultRichMenu(ctx context.Context, richMenuID string) error {
+	if c.channelAccessToken == "" || c.channelID == "" {
+		log.Println("LINE credentials not configured, skipping default rich menu")
+		return nil
+	}
+
+	return c.richMenuRequest(ctx, "POST", lineDefaultRichMenuEndpoint+url.PathEscape(richMenuID), "", nil, nil)
+}
+
+func (c *LineRichMenuClient) LinkRichMenu(ctx context.Context, richMenuID string, userIDs []string) error {
+	if len(userIDs) > model.RichMenuLinkMaxUsers {
+		return fmt.Errorf("rich menu link supports at most %d users, got %d", model.RichMenuLinkMaxUsers, len(userIDs))
+	}
+	if c.channelAccessToken == "" || c.channelID == "" {
+		log.Println("LINE credentials not configured, skipping rich menu link")
+		return nil
+	}
+
+	body, err := json.Marshal(LineRichMenuBulkLink{RichMenuID: richMenuID, UserIDs: userIDs})
+	if err != nil {
+		return fmt.Errorf("failed to marshal rich menu link: %w", err)
+	}
+	return c.richMenuRequest(ctx, "POST", lineRichMenuBulkLink, "application/json", body, nil)
+}
+
+func (c *LineRichMenuClient) UnlinkRichMenu(ctx context.Context, userIDs []string) error {
+	if len(userIDs) > model.RichMenuLinkMaxUsers {
+		return fmt.Errorf("rich menu unlink supports at most %d users, got %d", model.RichMenuLinkMaxUsers, len(userIDs))
+	}
+	if c.channelAccessToken == "" || c.channelID == "" {
+		log.Println("LINE credentials not configured, skipping rich menu unlink")
+		return nil
+	}
+
+	body, err := json.Marshal(LineRichMenuBulkUnlink{UserIDs: userIDs})
+	if err != nil {
+		return fmt.Errorf("failed to marshal rich menu unlink: %w", err)
+	}
+	return c.richMenuRequest(ctx, "POST", lineRichMenuBulkUnlink, "application/json", body, nil)
+}
+
+func (c *LineRichMenuClient) DeleteRichMenu(ctx context.Context, richMenuID string) error {
+	if c.channelAccessToken == "" || c.channelID == "" {
+		log.Println("LINE credentials not configured, skipping rich menu deletion")
+		return nil
+	}
+
+	return c.richMenuRequest(ctx, "DELETE", lineRichMenuEndpoint+"/"+url.PathEscape(richMenuID), "", nil, nil)
+}
+
+// richMenuRequest リッチメニューAPIにリクエストを送り、レスポンスを out に読み込む（out が nil の場合は読み込まない）。
+// リッチメニューが存在しない場合（404）は model.ErrLineRichMenuNotFound を返す
+func (c *LineRichMenuClient) richMenuRequest(ctx context.Context, method, endpoint, contentType string, body []byte, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.channelAccessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return model.ErrLineRichMenuNotFound
+	}
+	// 一括リンク・リンク解除は 202 Accepted を返す
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		respBody, _ := io.ReadAll(resp.Body)
+		log.Printf("LINE API error: status=%d, body=%s", resp.StatusCode, string(respBody))
+		return fmt.Errorf("LINE API error: status %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode LINE API response: %w", err)
+	}
+	return nil
+}
+
+// DummyRichMenuClient テスト・開発用のダミー実装
+type DummyRichMenuClient struct{}
+
+func NewDummyRichMenuClient() service.RichMenuClient {
+	return &DummyRichMenuClient{}
+}
+
+func (c *DummyRichMenuClient) CreateRichMenu(ctx context.Context, definition *model.RichMenuDefinition) (string, error) {
+	log.Printf("[DUMMY] Create rich menu - Name: %s, Areas: %d", definition.Name, len(definition.Areas))
+	return "dummy-" + uuid.NewString(), nil
+}
+
+func (c *DummyRichMenuClient) UploadRichMenuImage(ctx context.Context, richMenuID, contentType string, image []byte) error {
+	log.Printf("[DUMMY] Upload rich menu image - ID: %s, Type: %s, Bytes: %d", richMenuID, contentType, len(image))
+	return nil
+}
+
+func (c *DummyRichMenuClient) SetDefaultRichMenu(ctx context.Context, richMenuID string) error {
+	log.Printf("[DUMMY] Set default rich menu - ID: %s", richMenuID)
+	return nil
+}
+
+func (c *DummyRichMenuClient) LinkRichMenu(ctx context.Context, richMenuID string, userIDs []string) error {
+	log.Printf("[DUMMY] Link rich menu - ID: %s, Users: %d", richMenuID, len(userIDs))
+	return nil
+}
+
+func (c *DummyRichMenuClient) UnlinkRichMenu(ctx context.Context, userIDs []string) error {
+	log.Printf("[DUMMY] Unlink rich menu - Users: %d", len(userIDs))
+	return nil
+}
+
+func (c *DummyRichMenuClient) DeleteRichMenu(ctx context.Context, richMenuID string) error {
+	log.Printf("[DUMMY] Delete rich menu - ID: %s", richMenuID)
+	return nil
+}
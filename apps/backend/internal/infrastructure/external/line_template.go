@@ -0,0 +1,142 @@
+package external
+
+import (
+	"vt-link/backend/internal/domain/model"
+)
+
+type LineAction struct {
+	Type        string `json:"type"`
+	Label       string `json:"label,omitempty"`
+	Data        string `json:"data,omitempty"`
+	DisplayText string `json:"displayText,omitempty"`
+	Text        string `json:"text,omitempty"`
+	URI         string `json:"uri,omitempty"`
+	Mode        string `json:"mode,omitempty"`
+	Initial     string `json:"initial,omitempty"`
+	Max         string `json:"max,omitempty"`
+	Min         string `json:"min,omitempty"`
+}
+
+type LineTemplate struct {
+	Type     string      `json:"type"`
+	AltText  string      `json:"altText"`
+	Template interface{} `json:"template"`
+}
+
+type LineButtonsTemplate struct {
+	Type              string       `json:"type"`
+	ThumbnailImageURL string       `json:"thumbnailImageUrl,omitempty"`
+	Title             string       `json:"title,omitempty"`
+	Text              string       `json:"text"`
+	DefaultAction     *LineAction  `json:"defaultAction,omitempty"`
+	Actions           []LineAction `json:"actions"`
+}
+
+type LineConfirmTemplate struct {
+	Type    string       `json:"type"`
+	Text    string       `json:"text"`
+	Actions []LineAction `json:"actions"`
+}
+
+type LineCarouselTemplate struct {
+	Type    string               `json:"type"`
+	Columns []LineCarouselColumn `json:"columns"`
+}
+
+type LineCarouselColumn struct {
+	ThumbnailImageURL string       `json:"thumbnailImageUrl,omitempty"`
+	Title             string       `json:"title,omitempty"`
+	Text              string       `json:"text"`
+	DefaultAction     *LineAction  `json:"defaultAction,omitempty"`
+	Actions           []LineAction `json:"actions"`
+}
+
+type LineImageCarouselTemplate struct {
+	Type    string                    `json:"type"`
+	Columns []LineImageCarouselColumn `json:"columns"`
+}
+
+type LineImageCarouselColumn struct {
+	ImageURL string     `json:"imageUrl"`
+	Action   LineAction `json:"action"`
+}
+
+func buildLineTemplate(content *model.TemplateContent) LineTemplate {
+	var template interface{}
+	switch content.Type {
+	case model.TemplateTypeButtons:
+		template = LineButtonsTemplate{
+			Type:              "buttons",
+			ThumbnailImageURL: content.ThumbnailImageURL,
+			Title:             content.Title,
+			Text:              content.Text,
+			DefaultAction:     buildOptionalLineAction(content.DefaultAction),
+			Actions:           buildLineActions(content.Actions),
+		}
+	case model.TemplateTypeConfirm:
+		template = LineConfirmTemplate{
+			Type:    "confirm",
+			Text:    content.Text,
+			Actions: buildLineActions(content.Actions),
+		}
+	case model.TemplateTypeCarousel:
+		columns := make([]LineCarouselColumn, 0, len(content.Columns))
+		for _, column := range content.Columns {
+			columns = append(columns, LineCarouselColumn{
+				ThumbnailImageURL: column.ThumbnailImageURL,
+				Title:             column.Title,
+				Text:              column.Text,
+				DefaultAction:     buildOptionalLineAction(column.DefaultAction),
+				Actions:           buildLineActions(column.Actions),
+			})
+		}
+		template = LineCarouselTemplate{Type: "carousel", Columns: columns}
+	case model.TemplateTypeImageCarousel:
+		columns := make([]LineImageCarouselColumn, 0, len(content.Columns))
+		for _, column := range content.Columns {
+			columns = append(columns, LineImageCarouselColumn{
+				ImageURL: column.ImageURL,
+				Action:   BuildLineAction(*column.Action),
+			})
+		}
+		template = LineImageCarouselTemplate{Type: "image_carousel", Columns: columns}
+	}
+
+	return LineTemplate{
+		Type:     "template",
+		AltText:  content.AltText,
+		Template: template,
+	}
+}
+
+// BuildLineAction ActionをLINEのアクションオブジェクトに変換
+func BuildLineAction(action model.Action) LineAction {
+	return LineAction{
+		Type:        string(action.Type),
+		Label:       action.Label,
+		Data:        action.Data,
+		DisplayText: action.DisplayText,
+		Text:        action.Text,
+		URI:         action.URI,
+		Mode:        action.Mode,
+		Initial:     action.Initial,
+		Max:         action.Max,
+		Min:         action.Min,
+	}
+}
+
+func buildLineActions(actions []model.Action) []LineAction {
+	lineActions := make([]LineAction, 0, len(actions))
+	for _, action := range actions {
+		lineActions = append(lineActions, BuildLineAction(action))
+	}
+	return lineActions
+}
+
+func buildOptionalLineAction(action *model.Action) *LineAction {
+	if action == nil {
+		return nil
+	}
+	lineAction := BuildLineAction(*action)
+	return &lineAction
+}
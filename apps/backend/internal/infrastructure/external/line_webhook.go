@@ -0,0 +1,311 @@
+package external
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/service"
+)
+
+type LineWebhookParser struct {
+	channelSecret string
+}
+
+func NewLineWebhookParser() service.WebhookParser {
+	return &LineWebhookParser{
+		channelSecret: os.Getenv("LINE_CHANNEL_SECRET"),
+	}
+}
+
+func (p *LineWebhookParser) Parse(body []byte, signature string) (*model.WebhookPayload, error) {
+	if p.channelSecret == "" {
+		return nil, model.ErrWebhookNotConfigured
+	}
+	if !VerifyLineSignature(p.channelSecret, body, signature) {
+		return nil, model.ErrInvalidSignature
+	}
+
+	var request LineWebhookRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook body: %w", err)
+	}
+	return request.toModel(), nil
+}
+
+// VerifyLineSignature リクエストボディのHMAC-SHA256（チャネルシークレットで署名し、Base64エンコードしたもの）が署名と一致するか検証
+func VerifyLineSignature(channelSecret string, body []byte, signature string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(channelSecret))
+	mac.Write(body)
+	return hmac.Equal(decoded, mac.Sum(nil))
+}
+
+// LineWebhookRequest Webhookのリクエストボディ
+type LineWebhookRequest struct {
+	Destination string             `json:"destination"`
+	Events      []LineWebhookEvent `json:"events"`
+}
+
+// LineWebhookEvent Webhookイベントオブジェクト（種別ごとのフィールドは該当するイベントのみ）
+type LineWebhookEvent struct {
+	Type            string                 `json:"type"`
+	Mode            string                 `json:"mode"`
+	Timestamp       int64                  `json:"timestamp"`
+	Source          *LineWebhookSource     `json:"source"`
+	WebhookEventID  string                 `json:"webhookEventId"`
+	DeliveryContext LineDeliveryContext    `json:"deliveryContext"`
+	ReplyToken      string                 `json:"replyToken"`
+	Message         *LineWebhookMessage    `json:"message"`
+	Unsend          *LineWebhookUnsend     `json:"unsend"`
+	Follow          *LineWebhookFollow     `json:"follow"`
+	Joined          *LineWebhookMembers    `json:"joined"`
+	Left            *LineWebhookMembers    `json:"left"`
+	Postback        *LineWebhookPostback   `json:"postback"`
+	VideoPlay       *LineVideoPlayComplete `json:"videoPlayComplete"`
+	Beacon          *LineWebhookBeacon     `json:"beacon"`
+	Link            *LineAccountLink       `json:"link"`
+	Things          *LineWebhookThings     `json:"things"`
+	Membership      *LineWebhookMembership `json:"membership"`
+	Module          *LineWebhookModule     `json:"module"`
+	ChatControl     *LineChatControl       `json:"chatControl"`
+}
+
+type LineWebhookSource struct {
+	Type    string `json:"type"`
+	UserID  string `json:"userId"`
+	GroupID string `json:"groupId"`
+	RoomID  string `json:"roomId"`
+}
+
+type LineDeliveryContext struct {
+	IsRedelivery bool `json:"isRedelivery"`
+}
+
+type LineWebhookMessage struct {
+	ID                  string               `json:"id"`
+	Type                string               `json:"type"`
+	QuoteToken          string               `json:"quoteToken"`
+	QuotedMessageID     string               `json:"quotedMessageId"`
+	Text                string               `json:"text"`
+	Emojis              []LineEmoji          `json:"emojis"`
+	Mention             *LineMention         `json:"mention"`
+	ContentProvider     *LineContentProvider `json:"contentProvider"`
+	Duration            int64                `json:"duration"`
+	FileName            string               `json:"fileName"`
+	FileSize            int64                `json:"fileSize"`
+	Title               string               `json:"title"`
+	Address             string               `json:"address"`
+	Latitude            float64              `json:"latitude"`
+	Longitude           float64              `json:"longitude"`
+	PackageID           string               `json:"packageId"`
+	StickerID           string               `json:"stickerId"`
+	StickerResourceType string               `json:"stickerResourceType"`
+	Keywords            []string             `json:"keywords"`
+}
+
+type LineMention struct {
+	Mentionees []LineMentionee `json:"mentionees"`
+}
+
+type LineMentionee struct {
+	Index  int    `json:"index"`
+	Length int    `json:"length"`
+	Type   string `json:"type"`
+	UserID string `json:"userId"`
+	IsSelf bool   `json:"isSelf"`
+}
+
+type LineContentProvider struct {
+	Type               string `json:"type"`
+	OriginalContentURL string `json:"originalContentUrl"`
+	PreviewImageURL    string `json:"previewImageUrl"`
+}
+
+type LineWebhookUnsend struct {
+	MessageID string `json:"messageId"`
+}
+
+type LineWebhookFollow struct {
+	IsUnblocked bool `json:"isUnblocked"`
+}
+
+type LineWebhookMembers struct {
+	Members []LineWebhookSource `json:"members"`
+}
+
+type LineWebhookPostback struct {
+	Data   string            `json:"data"`
+	Params map[string]string `json:"params"`
+}
+
+type LineVideoPlayComplete struct {
+	TrackingID string `json:"trackingId"`
+}
+
+type LineWebhookBeacon struct {
+	HWID          string `json:"hwid"`
+	Type          string `json:"type"`
+	DeviceMessage string `json:"dm"`
+}
+
+type LineAccountLink struct {
+	Result string `json:"result"`
+	Nonce  string `json:"nonce"`
+}
+
+type LineWebhookThings struct {
+	DeviceID string          `json:"deviceId"`
+	Type     string          `json:"type"`
+	Result   json.RawMessage `json:"result"`
+}
+
+type LineWebhookMembership struct {
+	Type         string `json:"type"`
+	MembershipID int64  `json:"membershipId"`
+}
+
+type LineWebhookModule struct {
+	Type   string   `json:"type"`
+	BotID  string   `json:"botId"`
+	Scopes []string `json:"scopes"`
+}
+
+type LineChatControl struct {
+	ExpireAt int64 `json:"expireAt"`
+}
+
+// toModel LINEのリクエストボディをドメインのイベントに変換
+func (r *LineWebhookRequest) toModel() *model.WebhookPayload {
+	payload := &model.WebhookPayload{
+		Destination: r.Destination,
+		Events:      make([]*model.WebhookEvent, len(r.Events)),
+	}
+	for i := range r.Events {
+		payload.Events[i] = r.Events[i].toModel()
+	}
+	return payload
+}
+
+func (e *LineWebhookEvent) toModel() *model.WebhookEvent {
+	event := &model.WebhookEvent{
+		Type:           model.WebhookEventType(e.Type),
+		Mode:           e.Mode,
+		Timestamp:      time.UnixMilli(e.Timestamp),
+		WebhookEventID: e.WebhookEventID,
+		IsRedelivery:   e.DeliveryContext.IsRedelivery,
+		ReplyToken:     e.ReplyToken,
+	}
+	if e.Source != nil {
+		source := e.Source.toModel()
+		event.Source = &source
+	}
+	if e.Message != nil {
+		event.Message = e.Message.toModel()
+	}
+	if e.Follow != nil {
+		event.Follow = &model.WebhookFollow{IsUnblocked: e.Follow.IsUnblocked}
+	}
+	if e.Unsend != nil {
+		event.Unsend = &model.WebhookUnsend{MessageID: e.Unsend.MessageID}
+	}
+	if e.Joined != nil {
+		event.Joined = e.Joined.toModel()
+	}
+	if e.Left != nil {
+		event.Left = e.Left.toModel()
+	}
+	if e.Postback != nil {
+		event.Postback = &model.WebhookPostback{Data: e.Postback.Data, Params: e.Postback.Params}
+	}
+	if e.VideoPlay != nil {
+		event.VideoPlayComplete = &model.WebhookVideoPlayComplete{TrackingID: e.VideoPlay.TrackingID}
+	}
+	if e.Beacon != nil {
+		event.Beacon = &model.WebhookBeacon{HWID: e.Beacon.HWID, Type: e.Beacon.Type, DeviceMessage: e.Beacon.DeviceMessage}
+	}
+	if e.Link != nil {
+		event.AccountLink = &model.WebhookAccountLink{Result: e.Link.Result, Nonce: e.Link.Nonce}
+	}
+	if e.Things != nil {
+		event.Things = &model.WebhookThings{DeviceID: e.Things.DeviceID, Type: e.Things.Type, Result: e.Things.Result}
+	}
+	if e.Membership != nil {
+		event.Membership = &model.WebhookMembership{Type: e.Membership.Type, MembershipID: e.Membership.MembershipID}
+	}
+	if e.Module != nil {
+		event.Module = &model.WebhookModule{Type: e.Module.Type, BotID: e.Module.BotID, Scopes: e.Module.Scopes}
+	}
+	if e.ChatControl != nil {
+		event.ChatControl = &model.WebhookChatControl{ExpireAt: time.UnixMilli(e.ChatControl.ExpireAt)}
+	}
+	return event
+}
+
+func (s *LineWebhookSource) toModel() model.WebhookSource {
+	return model.WebhookSource{
+		Type:    model.WebhookSourceType(s.Type),
+		UserID:  s.UserID,
+		GroupID: s.GroupID,
+		RoomID:  s.RoomID,
+	}
+}
+
+func (m *LineWebhookMembers) toModel() *model.WebhookMembers {
+	members := make([]model.WebhookSource, len(m.Members))
+	for i := range m.Members {
+		members[i] = m.Members[i].toModel()
+	}
+	return &model.WebhookMembers{Members: members}
+}
+
+func (m *LineWebhookMessage) toModel() *model.WebhookMessage {
+	message := &model.WebhookMessage{
+		ID:                  m.ID,
+		Type:                model.WebhookMessageType(m.Type),
+		QuoteToken:          m.QuoteToken,
+		QuotedMessageID:     m.QuotedMessageID,
+		Text:                m.Text,
+		Duration:            m.Duration,
+		FileName:            m.FileName,
+		FileSize:            m.FileSize,
+		Title:               m.Title,
+		Address:             m.Address,
+		Latitude:            m.Latitude,
+		Longitude:           m.Longitude,
+		PackageID:           m.PackageID,
+		StickerID:           m.StickerID,
+		StickerResourceType: m.StickerResourceType,
+		Keywords:            m.Keywords,
+	}
+	for _, emoji := range m.Emojis {
+		message.Emojis = append(message.Emojis, model.TextEmoji{Index: emoji.Index, ProductID: emoji.ProductID, EmojiID: emoji.EmojiID})
+	}
+	if m.Mention != nil {
+		for _, mentionee := range m.Mention.Mentionees {
+			message.Mentionees = append(message.Mentionees, model.WebhookMentionee{
+				Index:  mentionee.Index,
+				Length: mentionee.Length,
+				Type:   mentionee.Type,
+				UserID: mentionee.UserID,
+				IsSelf: mentionee.IsSelf,
+			})
+		}
+	}
+	if m.ContentProvider != nil {
+		message.ContentProvider = &model.WebhookContentProvider{
+			Type:               m.ContentProvider.Type,
+			OriginalContentURL: m.ContentProvider.OriginalContentURL,
+			PreviewImageURL:    m.ContentProvider.PreviewImageURL,
+		}
+	}
+	return message
+}
@@ -0,0 +1,21 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+)
+
+// PathSegments prefix以降のURLパスを"/"区切りで返す（例: /api/messages/{id}/schedule → [id, schedule]）
+func PathSegments(r *http.Request, prefix string) []string {
+	rest := strings.TrimPrefix(r.URL.Path, prefix)
+	rest = strings.Trim(rest, "/")
+	if rest == "" {
+		return nil
+	}
+	return strings.Split(rest, "/")
+}
+
+// Actor リクエストの操作者（X-Actor ヘッダー。未指定の場合は空）
+func Actor(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get("X-Actor"))
+}
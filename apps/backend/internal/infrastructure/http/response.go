@@ -10,12 +10,20 @@ import (
 type Response struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
+	Meta    *Meta       `json:"meta,omitempty"`
 	Error   *ErrorInfo  `json:"error,omitempty"`
 }
 
+// Meta 一覧レスポンスのページング情報
+type Meta struct {
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
 type ErrorInfo struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Fields  []errx.FieldError `json:"fields,omitempty"`
 }
 
 // WriteJSON JSON形式でレスポンスを書き込み
@@ -31,6 +39,20 @@ func WriteJSON(w http.ResponseWriter, statusCode int, data interface{}) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// WriteJSONWithMeta ページング情報付きのJSONレスポンスを書き込み
+func WriteJSONWithMeta(w http.ResponseWriter, statusCode int, data interface{}, meta *Meta) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	response := Response{
+		Success: statusCode < 400,
+		Data:    data,
+		Meta:    meta,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
 // WriteError エラーレスポンスを書き込み
 func WriteError(w http.ResponseWriter, err error) {
 	w.Header().Set("Content-Type", "application/json")
@@ -42,6 +64,7 @@ func WriteError(w http.ResponseWriter, err error) {
 			Error: &ErrorInfo{
 				Code:    appErr.Code,
 				Message: appErr.Message,
+				Fields:  appErr.Fields,
 			},
 		}
 		json.NewEncoder(w).Encode(response)
@@ -69,6 +92,6 @@ func ParseJSON(r *http.Request, v interface{}) error {
 // SetCORS CORS헤더を設定
 func SetCORS(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Actor")
 }
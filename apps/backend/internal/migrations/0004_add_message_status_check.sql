@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ステータスを状態遷移表で定義された値に制限
+ALTER TABLE messages ADD CONSTRAINT chk_messages_status
+    CHECK (status IN ('draft', 'scheduled', 'sending', 'sent', 'failed', 'canceled', 'archived'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP CONSTRAINT IF EXISTS chk_messages_status;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- リッチコンテンツ（画像・動画・音声・スタンプ・位置情報）をJSONBで保存
+-- NULLの場合はタイトルと本文をテキストとして送信する
+ALTER TABLE messages ADD COLUMN content JSONB;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP COLUMN content;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- クイックリプライ（最大13項目）をJSONBで保存
+ALTER TABLE messages ADD COLUMN quick_reply JSONB;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP COLUMN quick_reply;
+-- +goose StatementEnd
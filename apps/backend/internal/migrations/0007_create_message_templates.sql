@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 繰り返し配信用のテンプレート（タイトル・本文に {{variable}} を含められる）
+CREATE TABLE message_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_message_templates_name ON message_templates(name);
+
+-- メッセージがどのテンプレートから作成されたかを記録
+ALTER TABLE messages ADD COLUMN template_id UUID REFERENCES message_templates(id) ON DELETE SET NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP COLUMN template_id;
+DROP TABLE IF EXISTS message_templates;
+-- +goose StatementEnd
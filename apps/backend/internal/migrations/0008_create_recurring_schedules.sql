@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 繰り返し配信の定義（cron式 / RRULE）。message_id のメッセージを雛形として配信する
+CREATE TABLE recurring_schedules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    expression TEXT NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_recurring_schedules_status CHECK (status IN ('active', 'paused'))
+);
+
+-- スケジューラが期限到来分を検索するためのインデックス
+CREATE INDEX idx_recurring_schedules_due ON recurring_schedules(next_run_at) WHERE status = 'active';
+CREATE INDEX idx_recurring_schedules_message_id ON recurring_schedules(message_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recurring_schedules;
+-- +goose StatementEnd
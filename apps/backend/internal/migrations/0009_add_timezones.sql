@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 予約時に指定された IANA タイムゾーン（未予約の場合は空）
+ALTER TABLE messages ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT '';
+
+-- ワークスペース全体の設定（1行のみ）
+CREATE TABLE workspace_settings (
+    id SMALLINT PRIMARY KEY DEFAULT 1,
+    default_timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tokyo',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_workspace_settings_singleton CHECK (id = 1)
+);
+
+INSERT INTO workspace_settings (id) VALUES (1);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS workspace_settings;
+ALTER TABLE messages DROP COLUMN timezone;
+-- +goose StatementEnd
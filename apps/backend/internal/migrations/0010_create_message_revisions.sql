@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- メッセージの保存履歴（作成・更新のたびに保存後の内容を連番で記録）
+CREATE TABLE message_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    content JSONB,
+    quick_reply JSONB,
+    status VARCHAR(50) NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE,
+    timezone VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_message_revisions_message_revision UNIQUE (message_id, revision)
+);
+
+-- 既存メッセージの現在の内容を初版として記録
+INSERT INTO message_revisions (message_id, revision, title, message, content, quick_reply, status, scheduled_at, timezone, created_at)
+SELECT id, 1, title, message, content, quick_reply, status, scheduled_at, timezone, updated_at
+FROM messages;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS message_revisions;
+-- +goose StatementEnd
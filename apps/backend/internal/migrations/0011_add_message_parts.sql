@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 1回のPushで順番に送信するメッセージ内容（最大5件）
+ALTER TABLE messages ADD COLUMN parts JSONB;
+ALTER TABLE message_revisions ADD COLUMN parts JSONB;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE message_revisions DROP COLUMN parts;
+ALTER TABLE messages DROP COLUMN parts;
+-- +goose StatementEnd
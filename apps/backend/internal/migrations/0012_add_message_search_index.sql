@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- タイトル・本文の部分一致検索用（単語区切りのない日本語でも使えるよう全文検索ではなくトライグラムを使う）。
+-- 日本語の文字をトライグラムに含めるため、DBの LC_CTYPE は C 以外（UTF-8 のロケール）である必要がある
+CREATE INDEX idx_messages_search_trgm ON messages USING GIN ((title || ' ' || message) gin_trgm_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_messages_search_trgm;
+-- +goose StatementEnd
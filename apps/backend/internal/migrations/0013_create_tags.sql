@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- メッセージを企画・シリーズ単位でまとめるタグ
+CREATE TABLE tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7) NOT NULL DEFAULT '#9E9E9E',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- タグ名は大文字小文字を区別せず一意
+CREATE UNIQUE INDEX idx_tags_name ON tags(LOWER(name));
+
+CREATE TABLE message_tags (
+    message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, tag_id)
+);
+
+CREATE INDEX idx_message_tags_tag_id ON message_tags(tag_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS message_tags;
+DROP TABLE IF EXISTS tags;
+-- +goose StatementEnd
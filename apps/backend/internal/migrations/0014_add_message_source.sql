@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 複製・繰り返し配信で作成されたメッセージの複製元（複製元が削除された場合は NULL）
+ALTER TABLE messages ADD COLUMN source_message_id UUID REFERENCES messages(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_messages_source_message_id ON messages(source_message_id) WHERE source_message_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP COLUMN source_message_id;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ゴミ箱に移した日時（NULL の場合はゴミ箱にない）
+ALTER TABLE messages ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_messages_deleted_at ON messages(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 送信先のLINEユーザーID（NULL の場合は既定の送信先）
+ALTER TABLE messages ADD COLUMN recipients JSONB;
+
+-- マルチキャスト（最大500ユーザー）1回分の送信結果。再送時は成功していないバッチだけを送る
+CREATE TABLE message_delivery_batches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    batch_index INTEGER NOT NULL,
+    recipients JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+    request_id TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (message_id, batch_index)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS message_delivery_batches;
+ALTER TABLE messages DROP COLUMN recipients;
+-- +goose StatementEnd
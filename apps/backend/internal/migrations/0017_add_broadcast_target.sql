@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 送信対象の種別（recipients: 指定ユーザーへのマルチキャスト、broadcast: 友だち全員）
+ALTER TABLE messages ADD COLUMN target VARCHAR(20) NOT NULL DEFAULT 'recipients'
+    CHECK (target IN ('recipients', 'broadcast'));
+
+-- ブロードキャストのバッチは宛先リストを持たず、届く友だち数の見込みを audience に記録する
+ALTER TABLE message_delivery_batches ADD COLUMN target VARCHAR(20) NOT NULL DEFAULT 'recipients';
+ALTER TABLE message_delivery_batches ADD COLUMN audience INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE message_delivery_batches ALTER COLUMN recipients DROP NOT NULL;
+
+UPDATE message_delivery_batches SET audience = jsonb_array_length(recipients);
+
+-- 月間の送信数の集計用
+CREATE INDEX idx_message_delivery_batches_sent_at ON message_delivery_batches(sent_at) WHERE status = 'succeeded';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM message_delivery_batches WHERE recipients IS NULL;
+ALTER TABLE message_delivery_batches ALTER COLUMN recipients SET NOT NULL;
+ALTER TABLE message_delivery_batches DROP COLUMN audience;
+ALTER TABLE message_delivery_batches DROP COLUMN target;
+ALTER TABLE messages DROP COLUMN target;
+-- +goose StatementEnd
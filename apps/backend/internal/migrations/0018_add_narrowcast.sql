@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 絞り込み配信の条件と、送信完了時に確定した送信数
+ALTER TABLE messages ADD COLUMN narrowcast JSONB;
+ALTER TABLE messages ADD COLUMN delivery_counts JSONB;
+
+ALTER TABLE messages DROP CONSTRAINT IF EXISTS messages_target_check;
+ALTER TABLE messages ADD CONSTRAINT messages_target_check
+    CHECK (target IN ('recipients', 'broadcast', 'narrowcast'));
+
+-- 絞り込み配信はLINEが受け付けた後、進捗の確認で送信完了を反映する
+ALTER TABLE message_delivery_batches DROP CONSTRAINT IF EXISTS message_delivery_batches_status_check;
+ALTER TABLE message_delivery_batches ADD CONSTRAINT message_delivery_batches_status_check
+    CHECK (status IN ('pending', 'accepted', 'succeeded', 'failed'));
+
+CREATE INDEX idx_message_delivery_batches_accepted ON message_delivery_batches(updated_at) WHERE status = 'accepted';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_message_delivery_batches_accepted;
+UPDATE message_delivery_batches SET status = 'pending' WHERE status = 'accepted';
+ALTER TABLE message_delivery_batches DROP CONSTRAINT IF EXISTS message_delivery_batches_status_check;
+ALTER TABLE message_delivery_batches ADD CONSTRAINT message_delivery_batches_status_check
+    CHECK (status IN ('pending', 'succeeded', 'failed'));
+UPDATE messages SET target = 'recipients' WHERE target = 'narrowcast';
+ALTER TABLE messages DROP CONSTRAINT IF EXISTS messages_target_check;
+ALTER TABLE messages ADD CONSTRAINT messages_target_check
+    CHECK (target IN ('recipients', 'broadcast'));
+ALTER TABLE messages DROP COLUMN delivery_counts;
+ALTER TABLE messages DROP COLUMN narrowcast;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- LINE公式アカウントの友だち（Webhookの follow / unfollow イベントで登録・更新）
+CREATE TABLE followers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    line_user_id VARCHAR(33) NOT NULL UNIQUE,
+    display_name TEXT NOT NULL DEFAULT '',
+    picture_url TEXT NOT NULL DEFAULT '',
+    status_message TEXT NOT NULL DEFAULT '',
+    language VARCHAR(20) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'following' CHECK (status IN ('following', 'unfollowed')),
+    followed_at TIMESTAMP WITH TIME ZONE,
+    unfollowed_at TIMESTAMP WITH TIME ZONE,
+    profile_updated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_followers_status_followed_at ON followers(status, followed_at DESC);
+
+-- 表示名の部分一致検索用
+CREATE INDEX idx_followers_display_name_trgm ON followers USING GIN (display_name gin_trgm_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS followers;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 受信したテキストメッセージに応答メッセージで返信するルール
+CREATE TABLE auto_reply_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    match_type VARCHAR(20) NOT NULL CHECK (match_type IN ('exact', 'contains', 'regex', 'fallback')),
+    keywords JSONB NOT NULL DEFAULT '[]',
+    replies JSONB NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    -- 応答する時間帯（{"start": "HH:MM", "end": "HH:MM"}、NULL の場合は終日）
+    time_window JSONB,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tokyo',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_auto_reply_rules_order ON auto_reply_rules(priority DESC, created_at ASC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS auto_reply_rules;
+-- +goose StatementEnd
@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- リッチメニューの定義（LINEへ再登録できるよう画像も保存する）
+CREATE TABLE rich_menus (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(300) NOT NULL,
+    chat_bar_text VARCHAR(14) NOT NULL,
+    size JSONB NOT NULL,
+    areas JSONB NOT NULL,
+    selected BOOLEAN NOT NULL DEFAULT FALSE,
+    revision INTEGER NOT NULL DEFAULT 1,
+    -- LINE上のリッチメニューID（定義を変更・再登録するたびに変わる）
+    line_rich_menu_id VARCHAR(64) NOT NULL DEFAULT '',
+    -- 既定のリッチメニュー（1つだけ。切り替えは1文で行う）
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    image BYTEA,
+    image_content_type VARCHAR(20) NOT NULL DEFAULT '',
+    applied_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- 定義の履歴（Revision ごとに変更後の定義を記録）
+CREATE TABLE rich_menu_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    rich_menu_id UUID NOT NULL REFERENCES rich_menus(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    name VARCHAR(300) NOT NULL,
+    chat_bar_text VARCHAR(14) NOT NULL,
+    size JSONB NOT NULL,
+    areas JSONB NOT NULL,
+    selected BOOLEAN NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_rich_menu_revisions_rich_menu_revision UNIQUE (rich_menu_id, revision)
+);
+
+-- ユーザーごとにリンクしたリッチメニュー（LINEへ再登録した際にリンクし直す）
+CREATE TABLE rich_menu_links (
+    line_user_id VARCHAR(64) PRIMARY KEY,
+    rich_menu_id UUID NOT NULL REFERENCES rich_menus(id) ON DELETE CASCADE,
+    linked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_rich_menu_links_rich_menu_id ON rich_menu_links(rich_menu_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rich_menu_links;
+DROP TABLE IF EXISTS rich_menu_revisions;
+DROP TABLE IF EXISTS rich_menus;
+-- +goose StatementEnd
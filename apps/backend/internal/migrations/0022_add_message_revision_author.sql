@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 変更者（リクエストの X-Actor ヘッダー。スケジューラによる変更など、指定がない場合は NULL）
+ALTER TABLE message_revisions ADD COLUMN changed_by VARCHAR(255);
+
+-- 最新のリビジョン番号。更新時に行ロックを取ったまま採番するため、同時に更新しても番号が重複しない
+ALTER TABLE messages ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+
+UPDATE messages
+SET revision = r.revision
+FROM (SELECT message_id, MAX(revision) AS revision FROM message_revisions GROUP BY message_id) r
+WHERE r.message_id = messages.id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP COLUMN revision;
+ALTER TABLE message_revisions DROP COLUMN changed_by;
+-- +goose StatementEnd
@@ -9,6 +9,14 @@ type AppError struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 	Status  int    `json:"-"`
+	// Fields 入力検証エラーの対象フィールド（フィールド単位のエラーがある場合のみ）
+	Fields []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError フィールド単位の入力検証エラー
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 func (e *AppError) Error() string {
@@ -51,6 +59,16 @@ func NewAppError(code, message string, status int) *AppError {
 	}
 }
 
+// NewValidationError フィールド単位のエラーを持つ入力検証エラーを作成
+func NewValidationError(code, message string, fields []FieldError) *AppError {
+	return &AppError{
+		Code:    code,
+		Message: message,
+		Status:  http.StatusBadRequest,
+		Fields:  fields,
+	}
+}
+
 // IsAppError AppErrorかどうかを判定
 func IsAppError(err error) (*AppError, bool) {
 	if appErr, ok := err.(*AppError); ok {
@@ -0,0 +1,96 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+	"vt-link/backend/internal/infrastructure/db/pg"
+)
+
+type FollowerRepositoryIntegrationTestSuite struct {
+	suite.Suite
+	testDB *TestDB
+	repo   repository.FollowerRepository
+	ctx    context.Context
+}
+
+func (s *FollowerRepositoryIntegrationTestSuite) SetupSuite() {
+	s.testDB = SetupTestDB(s.T())
+	s.repo = pg.NewFollowerRepository(&db.DB{DB: s.testDB.DB})
+	s.ctx = context.Background()
+}
+
+func (s *FollowerRepositoryIntegrationTestSuite) TearDownSuite() {
+	s.testDB.TeardownTestDB()
+}
+
+func (s *FollowerRepositoryIntegrationTestSuite) SetupTest() {
+	s.testDB.ClearAllTables(s.T())
+}
+
+func (s *FollowerRepositoryIntegrationTestSuite) newFollower(i int, name string, followedAt time.Time) *model.Follower {
+	follower := model.NewFollower(fmt.Sprintf("U%032x", i))
+	follower.Follow(followedAt)
+	follower.SetProfile(&model.LineProfile{DisplayName: name, Language: "ja"}, followedAt)
+	return follower
+}
+
+func (s *FollowerRepositoryIntegrationTestSuite) TestSave_UpsertsByLineUserID() {
+	now := time.Now().Truncate(time.Microsecond)
+	first := s.newFollower(1, "リンク", now)
+	assert.NoError(s.T(), s.repo.Save(s.ctx, first))
+
+	// 同じLINEユーザーを別のIDで保存しても、登録済みの行を更新する
+	duplicate := model.NewFollower(first.LineUserID)
+	duplicate.Unfollow(now.Add(time.Minute))
+	assert.NoError(s.T(), s.repo.Save(s.ctx, duplicate))
+	assert.Equal(s.T(), first.ID, duplicate.ID)
+
+	found, err := s.repo.FindByLineUserID(s.ctx, first.LineUserID)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), first.ID, found.ID)
+	assert.Equal(s.T(), model.FollowerStatusUnfollowed, found.Status)
+
+	_, err = s.repo.FindByLineUserID(s.ctx, fmt.Sprintf("U%032x", 99))
+	assert.Error(s.T(), err)
+}
+
+func (s *FollowerRepositoryIntegrationTestSuite) TestList_FilterAndSearch() {
+	now := time.Now()
+	link := s.newFollower(1, "リンク", now.Add(-2*time.Hour))
+	zelda := s.newFollower(2, "ゼルダ", now.Add(-time.Hour))
+	gone := s.newFollower(3, "リンクの友だち", now.Add(-3*time.Hour))
+	gone.Unfollow(now)
+	for _, f := range []*model.Follower{link, zelda, gone} {
+		assert.NoError(s.T(), s.repo.Save(s.ctx, f))
+	}
+
+	following := &model.FollowerFilter{Status: model.FollowerStatusFollowing}
+	followers, err := s.repo.List(s.ctx, following, 10, 0)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), followers, 2)
+	assert.Equal(s.T(), zelda.ID, followers[0].ID)
+
+	byName := &model.FollowerFilter{Query: "リンク"}
+	count, err := s.repo.Count(s.ctx, byName)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, count)
+
+	byID := &model.FollowerFilter{Query: zelda.LineUserID}
+	followers, err = s.repo.List(s.ctx, byID, 10, 0)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), followers, 1)
+	assert.Equal(s.T(), "ゼルダ", followers[0].DisplayName)
+}
+
+func TestFollowerRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowerRepositoryIntegrationTestSuite))
+}
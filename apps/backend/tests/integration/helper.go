@@ -9,6 +9,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 
+	"vt-link/backend/internal/domain/model"
 	"vt-link/backend/internal/infrastructure/db"
 	"vt-link/backend/internal/infrastructure/di"
 )
@@ -60,6 +61,14 @@ func (tdb *TestDB) ClearAllTables(t *testing.T) {
 	// PostgreSQL用のクリーンアップ（CASCADE付きTRUNCATE）
 	tables := []string{
 		"messages", // 依存関係の順序に注意
+		"message_templates",
+		"recurring_schedules",
+		"message_revisions",
+		"tags",
+		"message_delivery_batches",
+		"followers",
+		"auto_reply_rules",
+		"rich_menus",
 	}
 
 	tx, err := tdb.DB.BeginTxx(ctx, nil)
@@ -139,8 +148,7 @@ type MockPushTextCall struct {
 }
 
 type MockPushMessageCall struct {
-	Title string
-	Body  string
+	Message *model.Message
 }
 
 func (m *MockPusher) PushText(ctx context.Context, text string) error {
@@ -151,8 +159,8 @@ func (m *MockPusher) PushText(ctx context.Context, text string) error {
 	return nil
 }
 
-func (m *MockPusher) PushMessage(ctx context.Context, title, body string) error {
-	m.PushMessageCalls = append(m.PushMessageCalls, MockPushMessageCall{Title: title, Body: body})
+func (m *MockPusher) PushMessage(ctx context.Context, message *model.Message) error {
+	m.PushMessageCalls = append(m.PushMessageCalls, MockPushMessageCall{Message: message})
 	if m.ShouldFail {
 		return fmt.Errorf("mock push message failed")
 	}
@@ -184,7 +192,7 @@ func (m *MockPusher) AssertPushTextCalled(t *testing.T, expectedText string) {
 func (m *MockPusher) AssertPushMessageCalled(t *testing.T, expectedTitle, expectedBody string) {
 	found := false
 	for _, call := range m.PushMessageCalls {
-		if call.Title == expectedTitle && call.Body == expectedBody {
+		if call.Message.Title == expectedTitle && call.Message.Body == expectedBody {
 			found = true
 			break
 		}
@@ -2,6 +2,8 @@ package integration
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -64,6 +66,24 @@ func (s *MessageRepositoryIntegrationTestSuite) TestCreate_Success() {
 	assert.Equal(s.T(), message.Status, retrieved.Status)
 }
 
+func (s *MessageRepositoryIntegrationTestSuite) TestCreate_WithContent() {
+	// リッチコンテンツがJSONBとして往復できること
+	message := model.NewMessage("スタンプ", "")
+	message.Content = &model.MessageContent{
+		Type:    model.ContentTypeSticker,
+		Sticker: &model.StickerContent{PackageID: "446", StickerID: "1988"},
+	}
+
+	err := s.repo.Create(s.ctx, message)
+	assert.NoError(s.T(), err)
+
+	retrieved, err := s.repo.FindByID(s.ctx, message.ID)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), retrieved.Content)
+	assert.Equal(s.T(), model.ContentTypeSticker, retrieved.Content.Type)
+	assert.Equal(s.T(), "1988", retrieved.Content.Sticker.StickerID)
+}
+
 func (s *MessageRepositoryIntegrationTestSuite) TestFindByID_Success() {
 	// テスト用データを事前に作成
 	messageID := s.testDB.CreateTestMessage(s.T(), "取得テスト", "取得テストメッセージ")
@@ -128,7 +148,7 @@ func (s *MessageRepositoryIntegrationTestSuite) TestList_Success() {
 	s.testDB.CreateTestMessage(s.T(), "メッセージ3", "メッセージ3")
 
 	// メッセージ一覧を取得
-	messages, err := s.repo.List(s.ctx, 10, 0)
+	messages, err := s.repo.List(s.ctx, &model.MessageQuery{Sort: model.DefaultMessageSort, Limit: 10})
 
 	// アサーション
 	assert.NoError(s.T(), err)
@@ -184,6 +204,289 @@ func (s *MessageRepositoryIntegrationTestSuite) TestFindScheduledMessages_Succes
 }
 
 // テストスイートを実行するためのエントリーポイント
+func (s *MessageRepositoryIntegrationTestSuite) TestUpdate_RecordsRevisions() {
+	message := model.NewMessage("初版タイトル", "初版本文")
+	assert.NoError(s.T(), s.repo.Create(s.ctx, message))
+
+	message.Edit("改訂タイトル", "改訂本文")
+	message.ChangedBy = "editor@example.com"
+	assert.NoError(s.T(), s.repo.Update(s.ctx, message))
+
+	// 作成・更新のたびに保存後の内容が連番で記録される（新しい順）
+	revisions, err := s.repo.ListRevisions(s.ctx, message.ID)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), revisions, 2)
+	assert.Equal(s.T(), 2, revisions[0].Revision)
+	assert.Equal(s.T(), "改訂タイトル", revisions[0].Title)
+	if assert.NotNil(s.T(), revisions[0].ChangedBy) {
+		assert.Equal(s.T(), "editor@example.com", *revisions[0].ChangedBy)
+	}
+	assert.Equal(s.T(), 1, revisions[1].Revision)
+	assert.Equal(s.T(), "初版本文", revisions[1].Body)
+	assert.Nil(s.T(), revisions[1].ChangedBy)
+
+	first, err := s.repo.FindRevision(s.ctx, message.ID, 1)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "初版タイトル", first.Title)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestUpdate_ConcurrentRevisionsDoNotCollide() {
+	message := model.NewMessage("タイトル", "本文")
+	assert.NoError(s.T(), s.repo.Create(s.ctx, message))
+
+	// 同時に更新しても行ロックを取ったまま採番するため、リビジョン番号が重複しない
+	const updates = 5
+	var wg sync.WaitGroup
+	errs := make([]error, updates)
+	for i := 0; i < updates; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			updated := *message
+			updated.Edit(fmt.Sprintf("更新%d", i), "本文")
+			errs[i] = s.repo.Update(s.ctx, &updated)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		assert.NoError(s.T(), err)
+	}
+
+	revisions, err := s.repo.ListRevisions(s.ctx, message.ID)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), revisions, updates+1)
+	assert.Equal(s.T(), updates+1, revisions[0].Revision)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestList_FilterAndCursor() {
+	base := time.Date(2024, 1, 5, 11, 0, 0, 0, time.UTC)
+	var scheduled []*model.Message
+	for i := 0; i < 3; i++ {
+		message := model.NewMessage(fmt.Sprintf("予約%d", i), "本文")
+		assert.NoError(s.T(), message.Schedule(base.Add(time.Duration(i)*time.Hour)))
+		assert.NoError(s.T(), s.repo.Create(s.ctx, message))
+		scheduled = append(scheduled, message)
+	}
+	assert.NoError(s.T(), s.repo.Create(s.ctx, model.NewMessage("下書き", "本文")))
+
+	filter := model.MessageFilter{Statuses: []model.MessageStatus{model.MessageStatusScheduled}}
+	sort := model.MessageSort{Field: model.MessageSortScheduledAt, Desc: true}
+
+	first, err := s.repo.List(s.ctx, &model.MessageQuery{Filter: filter, Sort: sort, Limit: 2})
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), first, 2)
+	assert.Equal(s.T(), scheduled[2].ID, first[0].ID)
+	assert.Equal(s.T(), scheduled[1].ID, first[1].ID)
+
+	// 最後の行のカーソル以降だけが返る
+	cursor := model.NewMessageCursor(first[1], sort)
+	rest, err := s.repo.List(s.ctx, &model.MessageQuery{Filter: filter, Sort: sort, Cursor: cursor, Limit: 2})
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), rest, 1)
+	assert.Equal(s.T(), scheduled[0].ID, rest[0].ID)
+
+	count, err := s.repo.Count(s.ctx, &filter)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 3, count)
+
+	from := base.Add(30 * time.Minute)
+	count, err = s.repo.Count(s.ctx, &model.MessageFilter{ScheduledFrom: &from})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, count)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestSetTags_FilterAndUsageCount() {
+	tagRepo := pg.NewTagRepository(&db.DB{DB: s.testDB.DB})
+	stream, _ := model.NewTag("配信予定", "")
+	merch, _ := model.NewTag("グッズ", "")
+	assert.NoError(s.T(), tagRepo.Create(s.ctx, stream))
+	assert.NoError(s.T(), tagRepo.Create(s.ctx, merch))
+
+	tagged := model.NewMessage("週間スケジュール", "本文")
+	assert.NoError(s.T(), s.repo.Create(s.ctx, tagged))
+	assert.NoError(s.T(), s.repo.SetTags(s.ctx, tagged.ID, []uuid.UUID{stream.ID, merch.ID}))
+	assert.NoError(s.T(), s.repo.Create(s.ctx, model.NewMessage("タグなし", "本文")))
+
+	found, err := s.repo.FindByID(s.ctx, tagged.ID)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), found.Tags, 2)
+
+	filter := model.MessageFilter{TagIDs: []uuid.UUID{stream.ID}}
+	messages, err := s.repo.List(s.ctx, &model.MessageQuery{Filter: filter, Sort: model.DefaultMessageSort, Limit: 10})
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), messages, 1)
+	assert.Equal(s.T(), tagged.ID, messages[0].ID)
+
+	// 置き換えで外したタグは使用数に含まれない
+	assert.NoError(s.T(), s.repo.SetTags(s.ctx, tagged.ID, []uuid.UUID{merch.ID}))
+	tags, err := tagRepo.List(s.ctx, 10, 0)
+	assert.NoError(s.T(), err)
+	counts := map[string]int{}
+	for _, tag := range tags {
+		counts[tag.Name] = tag.UsageCount
+	}
+	assert.Equal(s.T(), map[string]int{"配信予定": 0, "グッズ": 1}, counts)
+
+	// ゴミ箱にあるメッセージはタグが残っていても使用数に含まれない（タグでの絞り込みと同じ）
+	assert.NoError(s.T(), tagged.Delete(time.Now()))
+	assert.NoError(s.T(), s.repo.Update(s.ctx, tagged))
+	tags, err = tagRepo.List(s.ctx, 10, 0)
+	assert.NoError(s.T(), err)
+	for _, tag := range tags {
+		assert.Equal(s.T(), 0, tag.UsageCount, tag.Name)
+	}
+	found, err = s.repo.FindByID(s.ctx, tagged.ID)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), found.Tags, 1)
+	merchFound, err := tagRepo.FindByID(s.ctx, merch.ID)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, merchFound.UsageCount)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestSearch_RanksTitleMatches() {
+	s.testDB.CreateTestMessage(s.T(), "定期連絡", "夏のライブ配信は8月です")
+	s.testDB.CreateTestMessage(s.T(), "夏のライブ告知", "詳細は後日お知らせします")
+	s.testDB.CreateTestMessage(s.T(), "冬のイベント", "ライブはありません")
+
+	results, err := s.repo.Search(s.ctx, []string{"夏", "ライブ"}, 10, 0)
+
+	// 全ての検索語を含むものだけがヒットし、タイトルでの一致が上位になる
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), results, 2)
+	assert.Equal(s.T(), "夏のライブ告知", results[0].Message.Title)
+	assert.Equal(s.T(), "定期連絡", results[1].Message.Title)
+	assert.Greater(s.T(), results[0].Rank, results[1].Rank)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestSearch_EscapesWildcards() {
+	s.testDB.CreateTestMessage(s.T(), "50%オフ", "セール")
+	s.testDB.CreateTestMessage(s.T(), "500円オフ", "セール")
+
+	results, err := s.repo.Search(s.ctx, []string{"50%"}, 10, 0)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), results, 1)
+	assert.Equal(s.T(), "50%オフ", results[0].Message.Title)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestSoftDelete_ExcludedFromListSearchAndScheduler() {
+	kept, err := s.repo.FindByID(s.ctx, uuid.MustParse(s.testDB.CreateTestMessage(s.T(), "残す予約", "本文")))
+	assert.NoError(s.T(), err)
+	deleted, err := s.repo.FindByID(s.ctx, uuid.MustParse(s.testDB.CreateTestMessage(s.T(), "消す予約", "本文")))
+	assert.NoError(s.T(), err)
+	for _, message := range []*model.Message{kept, deleted} {
+		assert.NoError(s.T(), message.Schedule(time.Now().Add(-time.Minute)))
+	}
+	assert.NoError(s.T(), deleted.Delete(time.Now()))
+	for _, message := range []*model.Message{kept, deleted} {
+		assert.NoError(s.T(), s.repo.Update(s.ctx, message))
+	}
+
+	scheduled, err := s.repo.FindScheduledMessages(s.ctx, time.Now(), 10)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), scheduled, 1)
+	assert.Equal(s.T(), kept.ID, scheduled[0].ID)
+
+	results, err := s.repo.Search(s.ctx, []string{"予約"}, 10, 0)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), results, 1)
+
+	// ゴミ箱の一覧には削除したものだけが含まれ、FindByID では引き続き取得できる
+	trash, err := s.repo.List(s.ctx, &model.MessageQuery{Filter: model.MessageFilter{Deleted: true}, Sort: model.DefaultTrashSort, Limit: 10})
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), trash, 1)
+	assert.Equal(s.T(), deleted.ID, trash[0].ID)
+
+	found, err := s.repo.FindByID(s.ctx, deleted.ID)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), found.DeletedAt)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestDeliveryBatches_RecordAndSumAudience() {
+	batchRepo := pg.NewDeliveryBatchRepository(&db.DB{DB: s.testDB.DB})
+	message := model.NewMessage("告知", "本文")
+	message.Recipients = model.Recipients{fmt.Sprintf("U%032x", 1), fmt.Sprintf("U%032x", 2)}
+	assert.NoError(s.T(), s.repo.Create(s.ctx, message))
+
+	now := time.Now()
+	batches := append(model.NewDeliveryBatches(message.ID, message.Recipients, now), model.NewBroadcastBatch(message.ID, 300, now))
+	batches[1].Index = 1
+	assert.NoError(s.T(), batchRepo.CreateBatches(s.ctx, batches))
+
+	batches[0].MarkAsSucceeded("req-0", now)
+	batches[1].MarkAsSucceeded("req-1", now)
+	for _, batch := range batches {
+		assert.NoError(s.T(), batchRepo.Update(s.ctx, batch))
+	}
+
+	found, err := batchRepo.ListByMessage(s.ctx, message.ID)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), found, 2)
+	assert.Equal(s.T(), message.Recipients, found[0].Recipients)
+	assert.Empty(s.T(), found[1].Recipients)
+	assert.Equal(s.T(), "req-1", found[1].RequestID)
+
+	usage, err := batchRepo.SumAudience(s.ctx, now.Add(-time.Hour))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, usage[model.DeliveryTargetRecipients])
+	assert.Equal(s.T(), 300, usage[model.DeliveryTargetBroadcast])
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestNarrowcast_RoundTripAndFindAccepted() {
+	batchRepo := pg.NewDeliveryBatchRepository(&db.DB{DB: s.testDB.DB})
+	message := model.NewMessage("絞り込み", "本文")
+	assert.NoError(s.T(), message.SetTarget(model.DeliveryTargetNarrowcast))
+	assert.NoError(s.T(), message.SetNarrowcast(&model.NarrowcastFilter{AudienceGroupIDs: []int64{101}, Areas: []string{"jp_13"}}))
+	assert.NoError(s.T(), s.repo.Create(s.ctx, message))
+
+	now := time.Now()
+	batch := model.NewNarrowcastBatch(message.ID, now)
+	assert.NoError(s.T(), batchRepo.CreateBatches(s.ctx, []*model.DeliveryBatch{batch}))
+	batch.MarkAsAccepted("req-narrow", now)
+	assert.NoError(s.T(), batchRepo.Update(s.ctx, batch))
+
+	accepted, err := batchRepo.FindAccepted(s.ctx, 10)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), accepted, 1)
+	assert.Equal(s.T(), "req-narrow", accepted[0].RequestID)
+
+	assert.NoError(s.T(), message.MarkAsSending())
+	assert.NoError(s.T(), message.CompleteNarrowcast(&model.NarrowcastProgress{Phase: model.NarrowcastPhaseSucceeded, TargetCount: 5, SuccessCount: 5}))
+	assert.NoError(s.T(), s.repo.Update(s.ctx, message))
+
+	found, err := s.repo.FindByID(s.ctx, message.ID)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), message.Narrowcast, found.Narrowcast)
+	assert.Equal(s.T(), &model.DeliveryCounts{Target: 5, Success: 5}, found.DeliveryCounts)
+}
+
+func (s *MessageRepositoryIntegrationTestSuite) TestFindStuckSending_SkipsAcceptedNarrowcasts() {
+	batchRepo := pg.NewDeliveryBatchRepository(&db.DB{DB: s.testDB.DB})
+	stuck := model.NewMessage("途中で終了", "本文")
+	assert.NoError(s.T(), stuck.MarkAsSending())
+	assert.NoError(s.T(), s.repo.Create(s.ctx, stuck))
+
+	// LINEが受け付けた絞り込み配信は進捗の確認で完了を反映するため対象外
+	narrowcast := model.NewMessage("絞り込み", "本文")
+	assert.NoError(s.T(), narrowcast.SetTarget(model.DeliveryTargetNarrowcast))
+	assert.NoError(s.T(), narrowcast.MarkAsSending())
+	assert.NoError(s.T(), s.repo.Create(s.ctx, narrowcast))
+	batch := model.NewNarrowcastBatch(narrowcast.ID, time.Now())
+	assert.NoError(s.T(), batchRepo.CreateBatches(s.ctx, []*model.DeliveryBatch{batch}))
+	batch.MarkAsAccepted("req-narrow", time.Now())
+	assert.NoError(s.T(), batchRepo.Update(s.ctx, batch))
+
+	found, err := s.repo.FindStuckSending(s.ctx, time.Now().Add(time.Minute), 10)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), found, 1)
+	assert.Equal(s.T(), stuck.ID, found[0].ID)
+
+	// 期限前のものは送信処理が続いている可能性があるため対象外
+	found, err = s.repo.FindStuckSending(s.ctx, time.Now().Add(-time.Minute), 10)
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), found)
+}
+
 func TestMessageRepositoryIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(MessageRepositoryIntegrationTestSuite))
 }
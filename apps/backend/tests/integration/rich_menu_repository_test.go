@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/repository"
+	"vt-link/backend/internal/infrastructure/db"
+	"vt-link/backend/internal/infrastructure/db/pg"
+)
+
+type RichMenuRepositoryIntegrationTestSuite struct {
+	suite.Suite
+	testDB *TestDB
+	repo   repository.RichMenuRepository
+	ctx    context.Context
+}
+
+func (s *RichMenuRepositoryIntegrationTestSuite) SetupSuite() {
+	s.testDB = SetupTestDB(s.T())
+	s.repo = pg.NewRichMenuRepository(&db.DB{DB: s.testDB.DB})
+	s.ctx = context.Background()
+}
+
+func (s *RichMenuRepositoryIntegrationTestSuite) TearDownSuite() {
+	s.testDB.TeardownTestDB()
+}
+
+func (s *RichMenuRepositoryIntegrationTestSuite) SetupTest() {
+	s.testDB.ClearAllTables(s.T())
+}
+
+func (s *RichMenuRepositoryIntegrationTestSuite) newRichMenu(name string) *model.RichMenu {
+	menu, err := model.NewRichMenu(model.RichMenuDefinition{
+		Name:        name,
+		ChatBarText: "メニュー",
+		Size:        model.RichMenuSize{Width: 2500, Height: 843},
+		Areas: model.RichMenuAreas{
+			{
+				Bounds: model.RichMenuBounds{X: 0, Y: 0, Width: 2500, Height: 843},
+				Action: model.Action{Type: model.ActionTypeURI, URI: "https://example.com"},
+			},
+		},
+	})
+	s.Require().NoError(err)
+	menu.MarkApplied("richmenu-"+name, time.Now())
+	s.Require().NoError(s.repo.Create(s.ctx, menu))
+	return menu
+}
+
+func (s *RichMenuRepositoryIntegrationTestSuite) TestUpdate_RecordsRevisionOnlyForNewDefinitions() {
+	menu := s.newRichMenu("main")
+
+	// 画像の登録だけでは Revision は進まない
+	s.Require().NoError(menu.SetImage("image/png", []byte("png")))
+	assert.NoError(s.T(), s.repo.Update(s.ctx, menu))
+
+	definition := menu.RichMenuDefinition
+	definition.ChatBarText = "お知らせ"
+	s.Require().NoError(menu.SetDefinition(definition))
+	assert.NoError(s.T(), s.repo.Update(s.ctx, menu))
+
+	revisions, err := s.repo.ListRevisions(s.ctx, menu.ID)
+	assert.NoError(s.T(), err)
+	s.Require().Len(revisions, 2)
+	assert.Equal(s.T(), 2, revisions[0].Revision)
+	assert.Equal(s.T(), "お知らせ", revisions[0].ChatBarText)
+	assert.Equal(s.T(), "メニュー", revisions[1].ChatBarText)
+
+	// 画像は FindByID でのみ読み込む
+	found, err := s.repo.FindByID(s.ctx, menu.ID)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []byte("png"), found.Image)
+	assert.Equal(s.T(), model.ActionTypeURI, found.Areas[0].Action.Type)
+	menus, err := s.repo.List(s.ctx, 10, 0)
+	assert.NoError(s.T(), err)
+	s.Require().Len(menus, 1)
+	assert.Nil(s.T(), menus[0].Image)
+	assert.Equal(s.T(), "image/png", menus[0].ImageContentType)
+}
+
+func (s *RichMenuRepositoryIntegrationTestSuite) TestSetDefault_SwitchesDefault() {
+	first := s.newRichMenu("first")
+	second := s.newRichMenu("second")
+
+	assert.NoError(s.T(), s.repo.SetDefault(s.ctx, first.ID, time.Now()))
+	assert.NoError(s.T(), s.repo.SetDefault(s.ctx, second.ID, time.Now()))
+
+	found, err := s.repo.FindByID(s.ctx, first.ID)
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), found.IsDefault)
+	found, err = s.repo.FindByID(s.ctx, second.ID)
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found.IsDefault)
+}
+
+func (s *RichMenuRepositoryIntegrationTestSuite) TestLinks_MoveBetweenMenusAndCascade() {
+	first := s.newRichMenu("first")
+	second := s.newRichMenu("second")
+	userIDs := []string{fmt.Sprintf("U%032x", 1), fmt.Sprintf("U%032x", 2), fmt.Sprintf("U%032x", 3)}
+
+	assert.NoError(s.T(), s.repo.LinkUsers(s.ctx, first.ID, userIDs, time.Now()))
+	// ユーザーにリンクできるリッチメニューは1つだけ
+	assert.NoError(s.T(), s.repo.LinkUsers(s.ctx, second.ID, userIDs[:1], time.Now()))
+	assert.NoError(s.T(), s.repo.UnlinkUsers(s.ctx, userIDs[1:2]))
+
+	linked, err := s.repo.ListLinkedUserIDs(s.ctx, first.ID)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), userIDs[2:], linked)
+
+	assert.NoError(s.T(), s.repo.Delete(s.ctx, second.ID))
+	linked, err = s.repo.ListLinkedUserIDs(s.ctx, second.ID)
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), linked)
+}
+
+func TestRichMenuRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(RichMenuRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,214 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/application/autoreply"
+	"vt-link/backend/internal/domain/model"
+	repoMocks "vt-link/backend/internal/domain/repository/mocks"
+	serviceMocks "vt-link/backend/internal/domain/service/mocks"
+	"vt-link/backend/internal/shared/errx"
+)
+
+type AutoReplyTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	clock            *fixedClock
+	mockRuleRepo     *repoMocks.MockAutoReplyRuleRepository
+	mockSettingsRepo *repoMocks.MockWorkspaceSettingsRepository
+	mockPusher       *serviceMocks.MockPusher
+	interactor       autoreply.Usecase
+}
+
+func (s *AutoReplyTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	// 2024-01-01 21:00 JST
+	s.clock = &fixedClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	s.mockRuleRepo = repoMocks.NewMockAutoReplyRuleRepository(s.T())
+	s.mockSettingsRepo = repoMocks.NewMockWorkspaceSettingsRepository(s.T())
+	s.mockPusher = serviceMocks.NewMockPusher(s.T())
+	s.interactor = autoreply.NewInteractor(s.mockRuleRepo, s.mockSettingsRepo, s.mockPusher, s.clock)
+}
+
+func (s *AutoReplyTestSuite) replies(text string) model.MessageParts {
+	return model.MessageParts{{Type: model.ContentTypeText, Text: &model.TextContent{Text: text}}}
+}
+
+func (s *AutoReplyTestSuite) rule(matchType model.AutoReplyMatchType, priority int, keywords ...string) *model.AutoReplyRule {
+	rule, err := model.NewAutoReplyRule(string(matchType), matchType, keywords, s.replies("応答"), priority, nil, "Asia/Tokyo")
+	s.Require().NoError(err)
+	return rule
+}
+
+func (s *AutoReplyTestSuite) textEvent(text string) *model.WebhookEvent {
+	return &model.WebhookEvent{
+		Type:           model.WebhookEventMessage,
+		Mode:           "active",
+		Timestamp:      s.clock.now,
+		Source:         &model.WebhookSource{Type: model.WebhookSourceUser, UserID: testLineUserID},
+		WebhookEventID: uuid.NewString(),
+		ReplyToken:     "nHuyWiB7yP5Zw52FIkcQobQuGDXCTA",
+		Message:        &model.WebhookMessage{ID: "444573844083572737", Type: model.WebhookMessageText, Text: text},
+	}
+}
+
+func (s *AutoReplyTestSuite) TestRule_MatchTypes() {
+	assert.True(s.T(), s.rule(model.AutoReplyMatchExact, 0, "配信予定").Matches(" 配信予定 "))
+	assert.False(s.T(), s.rule(model.AutoReplyMatchExact, 0, "配信予定").Matches("次の配信予定は？"))
+	assert.True(s.T(), s.rule(model.AutoReplyMatchContains, 0, "Schedule").Matches("next schedule?"))
+	assert.True(s.T(), s.rule(model.AutoReplyMatchRegex, 0, `^#\d+$`).Matches("#42"))
+	assert.False(s.T(), s.rule(model.AutoReplyMatchFallback, 0).Matches("何でも"))
+}
+
+func (s *AutoReplyTestSuite) TestRule_RegexCompiledOnce() {
+	// DBから読み込んだルール（Validate を通らない）も最初の照合でコンパイルし、以降は使い回す
+	loaded := &model.AutoReplyRule{MatchType: model.AutoReplyMatchRegex, Keywords: model.AutoReplyKeywords{`^#\d+$`, `^order-[a-z]+$`}}
+	assert.True(s.T(), loaded.Matches("#42"))
+	allocs := testing.AllocsPerRun(10, func() {
+		loaded.Matches("order-abc")
+	})
+	assert.Zero(s.T(), allocs)
+
+	// 検証時にキーワードの変更を反映する
+	rule := s.rule(model.AutoReplyMatchRegex, 0, `^order-[a-z]+$`)
+	assert.True(s.T(), rule.Matches("order-abc"))
+	rule.Keywords = model.AutoReplyKeywords{`^#\d+$`}
+	s.Require().NoError(rule.Validate())
+	assert.False(s.T(), rule.Matches("order-abc"))
+	assert.True(s.T(), rule.Matches("#42"))
+}
+
+func (s *AutoReplyTestSuite) TestMatchAutoReply_PriorityThenFallback() {
+	low := s.rule(model.AutoReplyMatchContains, 0, "配信")
+	high := s.rule(model.AutoReplyMatchContains, 10, "配信")
+	fallback := s.rule(model.AutoReplyMatchFallback, 100)
+	disabled := s.rule(model.AutoReplyMatchContains, 50, "配信")
+	disabled.Enabled = false
+	rules := []*model.AutoReplyRule{fallback, low, disabled, high}
+
+	// fallback は優先度に関わらずキーワードのルールより後に照合する
+	assert.Equal(s.T(), high.ID, model.MatchAutoReply(rules, "配信いつ？", s.clock.now).ID)
+	assert.Equal(s.T(), fallback.ID, model.MatchAutoReply(rules, "こんにちは", s.clock.now).ID)
+	assert.Nil(s.T(), model.MatchAutoReply([]*model.AutoReplyRule{low}, "こんにちは", s.clock.now))
+}
+
+func (s *AutoReplyTestSuite) TestMatchAutoReply_WindowAcrossMidnight() {
+	rule := s.rule(model.AutoReplyMatchContains, 0, "配信")
+	rule.Window = &model.AutoReplyWindow{Start: "22:00", End: "06:00"}
+	rules := []*model.AutoReplyRule{rule}
+
+	// 時間帯はルールのタイムゾーン（JST）で判定する
+	assert.Nil(s.T(), model.MatchAutoReply(rules, "配信", s.clock.now))                     // 21:00 JST
+	assert.NotNil(s.T(), model.MatchAutoReply(rules, "配信", s.clock.now.Add(2*time.Hour))) // 23:00 JST
+	assert.NotNil(s.T(), model.MatchAutoReply(rules, "配信", s.clock.now.Add(8*time.Hour))) // 05:00 JST
+	assert.Nil(s.T(), model.MatchAutoReply(rules, "配信", s.clock.now.Add(9*time.Hour)))    // 06:00 JST
+}
+
+func (s *AutoReplyTestSuite) TestCreateRule_ReportsAllFieldErrors() {
+	input := &autoreply.CreateRuleInput{
+		Name:      "正規表現",
+		MatchType: model.AutoReplyMatchRegex,
+		Keywords:  model.AutoReplyKeywords{"("},
+		Replies:   model.MessageParts{{Type: model.ContentTypeText, Text: &model.TextContent{Text: ""}}},
+		Window:    &model.AutoReplyWindow{Start: "25:00", End: "06:00"},
+		Timezone:  "Asia/Tokyo",
+	}
+
+	_, err := s.interactor.CreateRule(s.ctx, input)
+
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	assert.Equal(s.T(), "INVALID_AUTO_REPLY", appErr.Code)
+	fields := make([]string, len(appErr.Fields))
+	for i, field := range appErr.Fields {
+		fields[i] = field.Field
+	}
+	assert.Contains(s.T(), fields, "keywords[0]")
+	assert.Contains(s.T(), fields, "window.start")
+	assert.Contains(s.T(), fields, "replies[0].text.text")
+}
+
+func (s *AutoReplyTestSuite) TestCreateRule_UsesWorkspaceTimezone() {
+	settings := model.NewWorkspaceSettings()
+	settings.DefaultTimezone = "America/New_York"
+	s.mockSettingsRepo.EXPECT().Get(s.ctx).Return(settings, nil).Once()
+	s.mockRuleRepo.EXPECT().Create(s.ctx, mock.AnythingOfType("*model.AutoReplyRule")).Return(nil).Once()
+
+	rule, err := s.interactor.CreateRule(s.ctx, &autoreply.CreateRuleInput{
+		Name:      "不在時",
+		MatchType: model.AutoReplyMatchFallback,
+		Replies:   s.replies("ただいま不在です"),
+	})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "America/New_York", rule.Timezone)
+	assert.True(s.T(), rule.Enabled)
+}
+
+func (s *AutoReplyTestSuite) TestHandleMessageEvent_RepliesWithMatchedRule() {
+	rule := s.rule(model.AutoReplyMatchExact, 0, "配信予定")
+	event := s.textEvent("配信予定")
+	s.mockRuleRepo.EXPECT().ListEnabled(s.ctx).Return([]*model.AutoReplyRule{rule}, nil).Once()
+	s.mockPusher.EXPECT().Reply(s.ctx, event.ReplyToken, rule.Replies).Return(nil).Once()
+
+	err := s.interactor.HandleMessageEvent(s.ctx, event)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *AutoReplyTestSuite) TestHandleMessageEvent_NoMatchDoesNotReply() {
+	s.mockRuleRepo.EXPECT().ListEnabled(s.ctx).Return([]*model.AutoReplyRule{s.rule(model.AutoReplyMatchExact, 0, "配信予定")}, nil).Once()
+
+	err := s.interactor.HandleMessageEvent(s.ctx, s.textEvent("こんにちは"))
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *AutoReplyTestSuite) TestHandleMessageEvent_SkipsUnrepliableEvents() {
+	redelivered := s.textEvent("配信予定")
+	redelivered.IsRedelivery = true
+	standby := s.textEvent("配信予定")
+	standby.Mode = "standby"
+	sticker := s.textEvent("")
+	sticker.Message.Type = model.WebhookMessageSticker
+
+	// ルールの取得も返信も行わない
+	for _, event := range []*model.WebhookEvent{redelivered, standby, sticker} {
+		assert.NoError(s.T(), s.interactor.HandleMessageEvent(s.ctx, event))
+	}
+}
+
+func (s *AutoReplyTestSuite) TestHandleMessageEvent_ReplyFailure() {
+	rule := s.rule(model.AutoReplyMatchFallback, 0)
+	event := s.textEvent("こんにちは")
+	s.mockRuleRepo.EXPECT().ListEnabled(s.ctx).Return([]*model.AutoReplyRule{rule}, nil).Once()
+	s.mockPusher.EXPECT().Reply(s.ctx, event.ReplyToken, rule.Replies).Return(errors.New("LINE API error: status 400")).Once()
+
+	err := s.interactor.HandleMessageEvent(s.ctx, event)
+
+	assert.Error(s.T(), err)
+}
+
+func (s *AutoReplyTestSuite) TestTestRule_ReportsFallback() {
+	rule := s.rule(model.AutoReplyMatchFallback, 0)
+	s.mockRuleRepo.EXPECT().ListEnabled(s.ctx).Return([]*model.AutoReplyRule{rule}, nil).Once()
+
+	result, err := s.interactor.TestRule(s.ctx, &autoreply.TestRuleInput{Text: "こんにちは"})
+
+	s.Require().NoError(err)
+	assert.True(s.T(), result.Matched)
+	assert.True(s.T(), result.Fallback)
+	assert.Equal(s.T(), rule.ID, result.Rule.ID)
+}
+
+func TestAutoReplyTestSuite(t *testing.T) {
+	suite.Run(t, new(AutoReplyTestSuite))
+}
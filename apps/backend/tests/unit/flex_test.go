@@ -0,0 +1,106 @@
+package unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+const validBubble = `{
+	"type": "bubble",
+	"hero": {"type": "image", "url": "https://example.com/hero.png", "size": "full"},
+	"body": {
+		"type": "box",
+		"layout": "vertical",
+		"contents": [
+			{"type": "text", "text": "今夜21時から配信！", "weight": "bold"},
+			{"type": "separator"}
+		]
+	},
+	"footer": {
+		"type": "box",
+		"layout": "horizontal",
+		"contents": [
+			{"type": "button", "action": {"type": "uri", "label": "見に行く", "uri": "https://youtube.com/"}}
+		]
+	}
+}`
+
+type FlexTestSuite struct {
+	suite.Suite
+}
+
+func (s *FlexTestSuite) flex(contents string) *model.FlexContent {
+	return &model.FlexContent{
+		AltText:  "配信のお知らせ",
+		Contents: json.RawMessage(contents),
+	}
+}
+
+func (s *FlexTestSuite) TestValidate_Bubble() {
+	assert.NoError(s.T(), s.flex(validBubble).Validate())
+}
+
+func (s *FlexTestSuite) TestValidate_Carousel() {
+	carousel := fmt.Sprintf(`{"type": "carousel", "contents": [%s, %s]}`, validBubble, validBubble)
+	assert.NoError(s.T(), s.flex(carousel).Validate())
+}
+
+func (s *FlexTestSuite) TestValidate_AltTextRequired() {
+	f := s.flex(validBubble)
+	f.AltText = ""
+	assert.ErrorIs(s.T(), f.Validate(), model.ErrInvalidContent)
+}
+
+func (s *FlexTestSuite) TestValidate_AltTextTooLong() {
+	f := s.flex(validBubble)
+	f.AltText = strings.Repeat("あ", model.FlexAltTextMaxLength+1)
+	assert.ErrorIs(s.T(), f.Validate(), model.ErrInvalidContent)
+}
+
+func (s *FlexTestSuite) TestValidate_CarouselTooManyBubbles() {
+	bubbles := make([]string, model.FlexCarouselMaxBubbles+1)
+	for i := range bubbles {
+		bubbles[i] = `{"type": "bubble", "body": {"type": "box", "layout": "vertical", "contents": []}}`
+	}
+	carousel := fmt.Sprintf(`{"type": "carousel", "contents": [%s]}`, strings.Join(bubbles, ","))
+	assert.ErrorIs(s.T(), s.flex(carousel).Validate(), model.ErrInvalidContent)
+}
+
+func (s *FlexTestSuite) TestValidate_BubbleTooLarge() {
+	text := strings.Repeat("a", model.FlexBubbleMaxBytes)
+	bubble := fmt.Sprintf(`{"type": "bubble", "body": {"type": "box", "layout": "vertical", "contents": [{"type": "text", "text": %q}]}}`, text)
+	assert.ErrorIs(s.T(), s.flex(bubble).Validate(), model.ErrInvalidContent)
+}
+
+func (s *FlexTestSuite) TestValidate_InvalidStructure() {
+	testCases := []struct {
+		name     string
+		contents string
+	}{
+		{"not an object", `[]`},
+		{"unknown container", `{"type": "list"}`},
+		{"empty bubble", `{"type": "bubble"}`},
+		{"body is not a box", `{"type": "bubble", "body": {"type": "text", "text": "a"}}`},
+		{"box without layout", `{"type": "bubble", "body": {"type": "box", "contents": []}}`},
+		{"unknown component", `{"type": "bubble", "body": {"type": "box", "layout": "vertical", "contents": [{"type": "table"}]}}`},
+		{"button without action", `{"type": "bubble", "body": {"type": "box", "layout": "vertical", "contents": [{"type": "button"}]}}`},
+		{"http image", `{"type": "bubble", "hero": {"type": "image", "url": "http://example.com/a.png"}}`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			assert.ErrorIs(s.T(), s.flex(tc.contents).Validate(), model.ErrInvalidContent)
+		})
+	}
+}
+
+func TestFlexTestSuite(t *testing.T) {
+	suite.Run(t, new(FlexTestSuite))
+}
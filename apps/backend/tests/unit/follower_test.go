@@ -0,0 +1,155 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/application/follower"
+	"vt-link/backend/internal/domain/model"
+	repoMocks "vt-link/backend/internal/domain/repository/mocks"
+	serviceMocks "vt-link/backend/internal/domain/service/mocks"
+	"vt-link/backend/internal/shared/errx"
+)
+
+const testLineUserID = "U4af4980629c3b2f8b8a1c3e2f1d0e9a7"
+
+type FollowerTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	clock            *fixedClock
+	mockFollowerRepo *repoMocks.MockFollowerRepository
+	mockClient       *serviceMocks.MockProfileClient
+	interactor       follower.Usecase
+}
+
+func (s *FollowerTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.clock = &fixedClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	s.mockFollowerRepo = repoMocks.NewMockFollowerRepository(s.T())
+	s.mockClient = serviceMocks.NewMockProfileClient(s.T())
+	s.interactor = follower.NewInteractor(s.mockFollowerRepo, s.mockClient, s.clock)
+}
+
+func (s *FollowerTestSuite) event(eventType model.WebhookEventType, at time.Time) *model.WebhookEvent {
+	return &model.WebhookEvent{
+		Type:           eventType,
+		Timestamp:      at,
+		Source:         &model.WebhookSource{Type: model.WebhookSourceUser, UserID: testLineUserID},
+		WebhookEventID: uuid.NewString(),
+	}
+}
+
+func (s *FollowerTestSuite) TestFollower_IgnoresStaleEvents() {
+	f := model.NewFollower(testLineUserID)
+	followedAt := s.clock.now
+	unfollowedAt := followedAt.Add(time.Hour)
+
+	assert.True(s.T(), f.Unfollow(unfollowedAt))
+	// 友だち追加のイベントが後から届いても、より新しいブロックを上書きしない
+	assert.False(s.T(), f.Follow(followedAt))
+	assert.Equal(s.T(), model.FollowerStatusUnfollowed, f.Status)
+
+	assert.True(s.T(), f.Follow(unfollowedAt.Add(time.Minute)))
+	assert.Equal(s.T(), model.FollowerStatusFollowing, f.Status)
+}
+
+func (s *FollowerTestSuite) TestHandleFollowEvent_RegistersWithProfile() {
+	s.mockFollowerRepo.EXPECT().FindByLineUserID(s.ctx, testLineUserID).Return(nil, errors.New("follower not found")).Once()
+	s.mockClient.EXPECT().GetProfile(s.ctx, testLineUserID).Return(&model.LineProfile{
+		UserID:      testLineUserID,
+		DisplayName: "リンク",
+		PictureURL:  "https://profile.line-scdn.net/abc",
+		Language:    "ja",
+	}, nil).Once()
+	s.mockFollowerRepo.EXPECT().Save(s.ctx, mock.MatchedBy(func(f *model.Follower) bool {
+		return f.LineUserID == testLineUserID &&
+			f.Status == model.FollowerStatusFollowing &&
+			f.DisplayName == "リンク" &&
+			f.Language == "ja" &&
+			f.FollowedAt != nil && f.FollowedAt.Equal(s.clock.now)
+	})).Return(nil).Once()
+
+	err := s.interactor.HandleFollowEvent(s.ctx, s.event(model.WebhookEventFollow, s.clock.now))
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *FollowerTestSuite) TestHandleFollowEvent_SavesWithoutProfile() {
+	existing := model.NewFollower(testLineUserID)
+	existing.Unfollow(s.clock.now.Add(-time.Hour))
+	s.mockFollowerRepo.EXPECT().FindByLineUserID(s.ctx, testLineUserID).Return(existing, nil).Once()
+	s.mockClient.EXPECT().GetProfile(s.ctx, testLineUserID).Return(nil, errors.New("LINE API error: status 500")).Once()
+	s.mockFollowerRepo.EXPECT().Save(s.ctx, existing).Return(nil).Once()
+
+	err := s.interactor.HandleFollowEvent(s.ctx, s.event(model.WebhookEventFollow, s.clock.now))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.FollowerStatusFollowing, existing.Status)
+	assert.Nil(s.T(), existing.ProfileUpdatedAt)
+}
+
+func (s *FollowerTestSuite) TestHandleUnfollowEvent() {
+	existing := model.NewFollower(testLineUserID)
+	existing.Follow(s.clock.now.Add(-time.Hour))
+	s.mockFollowerRepo.EXPECT().FindByLineUserID(s.ctx, testLineUserID).Return(existing, nil).Once()
+	s.mockFollowerRepo.EXPECT().Save(s.ctx, existing).Return(nil).Once()
+
+	err := s.interactor.HandleUnfollowEvent(s.ctx, s.event(model.WebhookEventUnfollow, s.clock.now))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.FollowerStatusUnfollowed, existing.Status)
+	assert.True(s.T(), existing.UnfollowedAt.Equal(s.clock.now))
+}
+
+func (s *FollowerTestSuite) TestHandleFollowEvent_IgnoresGroupSource() {
+	event := s.event(model.WebhookEventFollow, s.clock.now)
+	event.Source = &model.WebhookSource{Type: model.WebhookSourceGroup, GroupID: "C0001"}
+
+	err := s.interactor.HandleFollowEvent(s.ctx, event)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *FollowerTestSuite) TestListFollowers() {
+	followers := []*model.Follower{model.NewFollower(testLineUserID)}
+	filter := &model.FollowerFilter{Status: model.FollowerStatusFollowing, Query: "リン"}
+	s.mockFollowerRepo.EXPECT().List(s.ctx, filter, 20, 0).Return(followers, nil).Once()
+	s.mockFollowerRepo.EXPECT().Count(s.ctx, filter).Return(1, nil).Once()
+
+	page, err := s.interactor.ListFollowers(s.ctx, &follower.ListFollowersInput{Status: model.FollowerStatusFollowing, Query: "リン", Limit: 20})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), followers, page.Followers)
+	assert.Equal(s.T(), 1, page.Total)
+}
+
+func (s *FollowerTestSuite) TestListFollowers_InvalidStatus() {
+	_, err := s.interactor.ListFollowers(s.ctx, &follower.ListFollowersInput{Status: "blocked"})
+
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	assert.Equal(s.T(), "INVALID_FILTER", appErr.Code)
+}
+
+func (s *FollowerTestSuite) TestRefreshProfile_RejectsUnfollowed() {
+	existing := model.NewFollower(testLineUserID)
+	existing.Unfollow(s.clock.now)
+	s.mockFollowerRepo.EXPECT().FindByID(s.ctx, existing.ID).Return(existing, nil).Once()
+
+	_, err := s.interactor.RefreshProfile(s.ctx, existing.ID)
+
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	assert.Equal(s.T(), 409, appErr.Status)
+}
+
+func TestFollowerTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowerTestSuite))
+}
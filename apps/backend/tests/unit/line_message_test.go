@@ -0,0 +1,229 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/infrastructure/external"
+)
+
+type LineMessageTestSuite struct {
+	suite.Suite
+	message *model.Message
+}
+
+func (s *LineMessageTestSuite) SetupTest() {
+	s.message = model.NewMessage("配信タイトル", "配信本文")
+}
+
+// marshal LINE APIに送られるJSONをmapとして取得
+func (s *LineMessageTestSuite) marshal(objects []interface{}) []map[string]interface{} {
+	data, err := json.Marshal(objects)
+	s.Require().NoError(err)
+
+	var decoded []map[string]interface{}
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	return decoded
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_TextFallback() {
+	// コンテンツ未設定ならタイトルと本文を結合したテキスト
+	objects, err := external.BuildLineMessages(s.message)
+
+	assert.NoError(s.T(), err)
+	decoded := s.marshal(objects)
+	assert.Len(s.T(), decoded, 1)
+	assert.Equal(s.T(), "text", decoded[0]["type"])
+	assert.Equal(s.T(), "配信タイトル\n\n配信本文", decoded[0]["text"])
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_Image() {
+	// 画像メッセージはLINEのキー名（camelCase）で出力される
+	s.message.Content = &model.MessageContent{
+		Type: model.ContentTypeImage,
+		Image: &model.ImageContent{
+			OriginalContentURL: "https://example.com/a.png",
+			PreviewImageURL:    "https://example.com/a_preview.png",
+		},
+	}
+
+	objects, err := external.BuildLineMessages(s.message)
+
+	assert.NoError(s.T(), err)
+	decoded := s.marshal(objects)
+	assert.Equal(s.T(), "image", decoded[0]["type"])
+	assert.Equal(s.T(), "https://example.com/a.png", decoded[0]["originalContentUrl"])
+	assert.Equal(s.T(), "https://example.com/a_preview.png", decoded[0]["previewImageUrl"])
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_StickerAndLocation() {
+	s.message.Content = &model.MessageContent{
+		Type:    model.ContentTypeSticker,
+		Sticker: &model.StickerContent{PackageID: "446", StickerID: "1988"},
+	}
+	objects, err := external.BuildLineMessages(s.message)
+	assert.NoError(s.T(), err)
+	decoded := s.marshal(objects)
+	assert.Equal(s.T(), "sticker", decoded[0]["type"])
+	assert.Equal(s.T(), "446", decoded[0]["packageId"])
+	assert.Equal(s.T(), "1988", decoded[0]["stickerId"])
+
+	s.message.Content = &model.MessageContent{
+		Type: model.ContentTypeLocation,
+		Location: &model.LocationContent{
+			Title:     "会場",
+			Address:   "東京都千代田区",
+			Latitude:  35.681236,
+			Longitude: 139.767125,
+		},
+	}
+	objects, err = external.BuildLineMessages(s.message)
+	assert.NoError(s.T(), err)
+	decoded = s.marshal(objects)
+	assert.Equal(s.T(), "location", decoded[0]["type"])
+	assert.Equal(s.T(), 35.681236, decoded[0]["latitude"])
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_Flex() {
+	// FlexはaltTextとコンテナJSONをそのまま出力する
+	s.message.Content = &model.MessageContent{
+		Type: model.ContentTypeFlex,
+		Flex: &model.FlexContent{
+			AltText:  "配信のお知らせ",
+			Contents: json.RawMessage(`{"type":"bubble","body":{"type":"box","layout":"vertical","contents":[{"type":"text","text":"今夜21時"}]}}`),
+		},
+	}
+
+	objects, err := external.BuildLineMessages(s.message)
+
+	assert.NoError(s.T(), err)
+	decoded := s.marshal(objects)
+	assert.Equal(s.T(), "flex", decoded[0]["type"])
+	assert.Equal(s.T(), "配信のお知らせ", decoded[0]["altText"])
+	assert.Equal(s.T(), "bubble", decoded[0]["contents"].(map[string]interface{})["type"])
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_ButtonsTemplate() {
+	// テンプレートはtemplateオブジェクトに包まれ、アクションはcamelCaseで出力される
+	s.message.Content = &model.MessageContent{
+		Type: model.ContentTypeTemplate,
+		Template: &model.TemplateContent{
+			Type:    model.TemplateTypeButtons,
+			AltText: "グッズ販売",
+			Text:    "本日20時から",
+			Actions: []model.Action{
+				{Type: model.ActionTypePostback, Label: "リマインド", Data: "remind=1", DisplayText: "リマインドする"},
+			},
+		},
+	}
+
+	objects, err := external.BuildLineMessages(s.message)
+
+	assert.NoError(s.T(), err)
+	decoded := s.marshal(objects)
+	assert.Equal(s.T(), "template", decoded[0]["type"])
+	assert.Equal(s.T(), "グッズ販売", decoded[0]["altText"])
+	template := decoded[0]["template"].(map[string]interface{})
+	assert.Equal(s.T(), "buttons", template["type"])
+	action := template["actions"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(s.T(), "postback", action["type"])
+	assert.Equal(s.T(), "リマインドする", action["displayText"])
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_QuickReply() {
+	// クイックリプライは元のメッセージオブジェクトにquickReplyとして付与される
+	s.message.Content = &model.MessageContent{
+		Type:    model.ContentTypeSticker,
+		Sticker: &model.StickerContent{PackageID: "446", StickerID: "1988"},
+	}
+	s.message.QuickReply = model.QuickReplyItems{
+		{ImageURL: "https://example.com/icon.png", Action: model.Action{Type: model.ActionTypeMessage, Label: "見たよ", Text: "見たよ！"}},
+		{Action: model.Action{Type: model.ActionTypeCamera, Label: "カメラ"}},
+	}
+
+	objects, err := external.BuildLineMessages(s.message)
+
+	assert.NoError(s.T(), err)
+	decoded := s.marshal(objects)
+	assert.Equal(s.T(), "sticker", decoded[0]["type"])
+	assert.Equal(s.T(), "1988", decoded[0]["stickerId"])
+	items := decoded[0]["quickReply"].(map[string]interface{})["items"].([]interface{})
+	assert.Len(s.T(), items, 2)
+	first := items[0].(map[string]interface{})
+	assert.Equal(s.T(), "action", first["type"])
+	assert.Equal(s.T(), "https://example.com/icon.png", first["imageUrl"])
+	assert.Equal(s.T(), "message", first["action"].(map[string]interface{})["type"])
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_PartsInOrder() {
+	// 画像→テキストの順で1回のPushにまとめ、クイックリプライは最後のパートに付く
+	s.Require().NoError(s.message.SetParts(model.MessageParts{
+		{Type: model.ContentTypeImage, Image: &model.ImageContent{
+			OriginalContentURL: "https://example.com/banner.png",
+			PreviewImageURL:    "https://example.com/banner_preview.png",
+		}},
+		{Type: model.ContentTypeText, Text: &model.TextContent{Text: "今夜21時から配信します"}},
+	}))
+	s.Require().NoError(s.message.SetQuickReply(model.QuickReplyItems{
+		{Action: model.Action{Type: model.ActionTypeMessage, Label: "見る", Text: "見る！"}},
+	}))
+
+	objects, err := external.BuildLineMessages(s.message)
+
+	assert.NoError(s.T(), err)
+	decoded := s.marshal(objects)
+	assert.Len(s.T(), decoded, 2)
+	assert.Equal(s.T(), "image", decoded[0]["type"])
+	assert.NotContains(s.T(), decoded[0], "quickReply")
+	assert.Equal(s.T(), "text", decoded[1]["type"])
+	assert.Equal(s.T(), "今夜21時から配信します", decoded[1]["text"])
+	assert.Contains(s.T(), decoded[1], "quickReply")
+}
+
+func (s *LineMessageTestSuite) TestSetParts_Limit() {
+	parts := make(model.MessageParts, model.MessageMaxParts+1)
+	for i := range parts {
+		parts[i] = model.MessageContent{Type: model.ContentTypeText, Text: &model.TextContent{Text: "本文"}}
+	}
+
+	assert.ErrorIs(s.T(), s.message.SetParts(parts), model.ErrInvalidContent)
+	assert.NoError(s.T(), s.message.SetParts(parts[:model.MessageMaxParts]))
+
+	// 不正なパートは位置付きでエラーになる
+	parts[1] = model.MessageContent{Type: model.ContentTypeImage, Image: &model.ImageContent{OriginalContentURL: "http://example.com/a.png", PreviewImageURL: "https://example.com/b.png"}}
+	err := s.message.SetParts(parts[:2])
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+	assert.Contains(s.T(), err.Error(), "parts[1]")
+}
+
+func (s *LineMessageTestSuite) TestSetParts_ReplacesContent() {
+	s.Require().NoError(s.message.SetContent(&model.MessageContent{Type: model.ContentTypeSticker, Sticker: &model.StickerContent{PackageID: "1", StickerID: "1"}}))
+	s.Require().NoError(s.message.SetParts(model.MessageParts{
+		{Type: model.ContentTypeText, Text: &model.TextContent{Text: "a"}},
+		{Type: model.ContentTypeText, Text: &model.TextContent{Text: "b"}},
+	}))
+
+	assert.Nil(s.T(), s.message.Content)
+	assert.Len(s.T(), s.message.Contents(), 2)
+}
+
+func (s *LineMessageTestSuite) TestBuildLineMessages_InvalidContent() {
+	// 必須項目が欠けたコンテンツはエラー
+	s.message.Content = &model.MessageContent{
+		Type:  model.ContentTypeAudio,
+		Audio: &model.AudioContent{OriginalContentURL: "https://example.com/a.m4a"},
+	}
+
+	objects, err := external.BuildLineMessages(s.message)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+	assert.Nil(s.T(), objects)
+}
+
+func TestLineMessageTestSuite(t *testing.T) {
+	suite.Run(t, new(LineMessageTestSuite))
+}
@@ -3,6 +3,7 @@ package unit
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,23 +19,46 @@ import (
 	"vt-link/backend/internal/shared/errx"
 )
 
+// fixedClock テスト用の固定時刻Clock
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
 type MessageInteractorTestSuite struct {
 	suite.Suite
-	interactor message.Usecase
-	mockRepo   *repoMocks.MockMessageRepository
-	mockPusher *serviceMocks.MockPusher
-	mockTxMgr  *repoMocks.MockTxManager
-	ctx        context.Context
+	interactor       message.Usecase
+	clock            *fixedClock
+	mockRepo         *repoMocks.MockMessageRepository
+	mockTemplateRepo *repoMocks.MockMessageTemplateRepository
+	mockTagRepo      *repoMocks.MockTagRepository
+	mockScheduleRepo *repoMocks.MockRecurringScheduleRepository
+	mockSettingsRepo *repoMocks.MockWorkspaceSettingsRepository
+	mockBatchRepo    *repoMocks.MockDeliveryBatchRepository
+	mockPusher       *serviceMocks.MockPusher
+	mockTxMgr        *repoMocks.MockTxManager
+	ctx              context.Context
 }
 
 func (s *MessageInteractorTestSuite) SetupTest() {
 	s.mockRepo = repoMocks.NewMockMessageRepository(s.T())
+	s.mockTemplateRepo = repoMocks.NewMockMessageTemplateRepository(s.T())
+	s.mockTagRepo = repoMocks.NewMockTagRepository(s.T())
+	s.mockScheduleRepo = repoMocks.NewMockRecurringScheduleRepository(s.T())
+	s.mockSettingsRepo = repoMocks.NewMockWorkspaceSettingsRepository(s.T())
+	s.mockBatchRepo = repoMocks.NewMockDeliveryBatchRepository(s.T())
 	s.mockPusher = serviceMocks.NewMockPusher(s.T())
 	s.mockTxMgr = repoMocks.NewMockTxManager(s.T())
 	s.ctx = context.Background()
 
-	// Clockはnilのまま（必要に応じて後で追加）
-	s.interactor = message.NewInteractor(s.mockRepo, s.mockTxMgr, s.mockPusher, nil)
+	// タイムゾーン未指定時はワークスペースの既定値（Asia/Tokyo）を参照する
+	s.mockSettingsRepo.EXPECT().Get(mock.Anything).Return(model.NewWorkspaceSettings(), nil).Maybe()
+
+	s.clock = &fixedClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	s.interactor = message.NewInteractor(s.mockRepo, s.mockTemplateRepo, s.mockTagRepo, s.mockScheduleRepo, s.mockSettingsRepo, s.mockBatchRepo, s.mockTxMgr, s.mockPusher, s.clock)
 }
 
 func (s *MessageInteractorTestSuite) TestCreateMessage_Success() {
@@ -100,14 +124,18 @@ func (s *MessageInteractorTestSuite) TestSendMessage_Success() {
 		}).Return(nil).Once()
 
 	// 2. メッセージ取得が呼ばれる（トランザクション内）
-	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(existingMessage, nil).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, messageID).Return(existingMessage, nil).Once()
 
-	// 3. プッシュサービスが呼ばれる（タイトルとメッセージが結合されたもの）
-	expectedMessage := fmt.Sprintf("%s\n\n%s", existingMessage.Title, existingMessage.Body)
-	s.mockPusher.EXPECT().PushText(s.ctx, expectedMessage).Return(nil).Once()
-
-	// 4. メッセージ更新が呼ばれる（送信済みステータスに変更）
+	// 3. 送信前に送信中ステータスがコミットされる（トランザクション内）
 	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.ID == messageID && c.Status == model.MessageStatusSending
+	})).Return(nil).Once()
+
+	// 4. プッシュサービスが呼ばれる（メッセージ内容の組み立てはPusher側）
+	s.mockPusher.EXPECT().PushMessage(s.ctx, existingMessage).Return(nil).Once()
+
+	// 5. メッセージ更新が呼ばれる（送信済みステータスに変更）
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(c *model.Message) bool {
 		return c.ID == messageID && c.Status == model.MessageStatusSent
 	})).Return(nil).Once()
 
@@ -133,7 +161,7 @@ func (s *MessageInteractorTestSuite) TestSendMessage_MessageNotFound() {
 		}).Return(errx.ErrNotFound).Once()
 
 	// 2. メッセージ取得が呼ばれるがNotFoundエラーを返す
-	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(nil, errx.ErrNotFound).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, messageID).Return(nil, errx.ErrNotFound).Once()
 
 	// テスト実行
 	err := s.interactor.SendMessage(s.ctx, input)
@@ -165,7 +193,7 @@ func (s *MessageInteractorTestSuite) TestSendMessage_AlreadySent() {
 		}).Return(errx.NewAppError("CANNOT_SEND", "Message cannot be sent", 400)).Once()
 
 	// 2. メッセージ取得が呼ばれる（送信済みステータス）
-	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(alreadySentMessage, nil).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, messageID).Return(alreadySentMessage, nil).Once()
 
 	// テスト実行
 	err := s.interactor.SendMessage(s.ctx, input)
@@ -198,17 +226,21 @@ func (s *MessageInteractorTestSuite) TestSendMessage_PushServiceFailure() {
 	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(ctx context.Context, fn func(context.Context) error) {
 			fn(ctx) // 渡された関数を実行
-		}).Return(errx.NewAppError("PUSH_FAILED", "Failed to send message", 500)).Once()
+		}).Return(nil).Once()
 
 	// 2. メッセージ取得が呼ばれる
-	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(existingMessage, nil).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, messageID).Return(existingMessage, nil).Once()
 
-	// 3. プッシュサービスが失敗する
-	expectedMessage := fmt.Sprintf("%s\n\n%s", existingMessage.Title, existingMessage.Body)
-	s.mockPusher.EXPECT().PushText(s.ctx, expectedMessage).Return(pushError).Once()
-
-	// 4. メッセージ更新が呼ばれる（失敗ステータスに変更）
+	// 3. 送信前に送信中ステータスがコミットされる
 	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.ID == messageID && c.Status == model.MessageStatusSending
+	})).Return(nil).Once()
+
+	// 4. プッシュサービスが失敗する（トランザクションの外）
+	s.mockPusher.EXPECT().PushMessage(s.ctx, existingMessage).Return(pushError).Once()
+
+	// 5. メッセージ更新が呼ばれる（失敗ステータスに変更）
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(c *model.Message) bool {
 		return c.ID == messageID && c.Status == model.MessageStatusFailed
 	})).Return(nil).Once()
 
@@ -243,8 +275,9 @@ func (s *MessageInteractorTestSuite) TestListMessages_Success() {
 		},
 	}
 
-	// Mockの期待値を設定
-	s.mockRepo.EXPECT().List(s.ctx, 100, 0).Return(expectedMessages, nil).Once()
+	// Mockの期待値を設定（次ページの有無を判定するため1件多く取得する）
+	s.mockRepo.EXPECT().List(s.ctx, &model.MessageQuery{Sort: model.DefaultMessageSort, Limit: 101}).Return(expectedMessages, nil).Once()
+	s.mockRepo.EXPECT().Count(s.ctx, &model.MessageFilter{}).Return(2, nil).Once()
 
 	// テスト実行
 	output, err := s.interactor.ListMessages(s.ctx, &message.ListMessagesInput{})
@@ -252,12 +285,1293 @@ func (s *MessageInteractorTestSuite) TestListMessages_Success() {
 	// アサーション
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), output)
-	assert.Len(s.T(), output, 2)
-	assert.Equal(s.T(), expectedMessages[0].Title, output[0].Title)
-	assert.Equal(s.T(), expectedMessages[1].Title, output[1].Title)
+	assert.Len(s.T(), output.Messages, 2)
+	assert.Equal(s.T(), 2, output.Total)
+	assert.Empty(s.T(), output.NextCursor)
+	assert.Equal(s.T(), expectedMessages[0].Title, output.Messages[0].Title)
+	assert.Equal(s.T(), expectedMessages[1].Title, output.Messages[1].Title)
+}
+
+func (s *MessageInteractorTestSuite) TestListMessages_NextCursor() {
+	scheduledAt := time.Date(2024, 1, 5, 11, 0, 0, 0, time.UTC)
+	messages := []*model.Message{
+		{ID: uuid.New(), Title: "1", Status: model.MessageStatusScheduled, ScheduledAt: &scheduledAt},
+		{ID: uuid.New(), Title: "2", Status: model.MessageStatusScheduled},
+	}
+	filter := model.MessageFilter{Statuses: []model.MessageStatus{model.MessageStatusScheduled}}
+	sort := model.MessageSort{Field: model.MessageSortScheduledAt}
+	s.mockRepo.EXPECT().List(s.ctx, &model.MessageQuery{Filter: filter, Sort: sort, Limit: 2}).Return(messages, nil).Once()
+	s.mockRepo.EXPECT().Count(s.ctx, &filter).Return(5, nil).Once()
+
+	output, err := s.interactor.ListMessages(s.ctx, &message.ListMessagesInput{Limit: 1, Sort: "scheduled_at", Statuses: filter.Statuses})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), output.Messages, 1)
+	assert.Equal(s.T(), 5, output.Total)
+
+	// 返したカーソルで次のページを取得すると、最後の行の続きから検索される
+	cursor, err := model.DecodeMessageCursor(output.NextCursor, sort)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), messages[0].ID, cursor.ID)
+	s.mockRepo.EXPECT().List(s.ctx, mock.MatchedBy(func(q *model.MessageQuery) bool {
+		return q.Cursor != nil && q.Cursor.ID == messages[0].ID && q.Offset == 0
+	})).Return(messages[1:], nil).Once()
+	s.mockRepo.EXPECT().Count(s.ctx, &filter).Return(5, nil).Once()
+
+	next, err := s.interactor.ListMessages(s.ctx, &message.ListMessagesInput{Limit: 1, Offset: 3, Sort: "scheduled_at", Statuses: filter.Statuses, Cursor: output.NextCursor})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), next.Messages, 1)
+	assert.Empty(s.T(), next.NextCursor)
+}
+
+func (s *MessageInteractorTestSuite) TestListMessages_InvalidQuery() {
+	cursor := model.NewMessageCursor(&model.Message{ID: uuid.New()}, model.DefaultMessageSort).Encode()
+	cases := map[string]struct {
+		input *message.ListMessagesInput
+		code  string
+	}{
+		"unknown sort":          {&message.ListMessagesInput{Sort: "-status"}, "INVALID_SORT"},
+		"unknown status":        {&message.ListMessagesInput{Statuses: []model.MessageStatus{"deleted"}}, "INVALID_FILTER"},
+		"cursor for other sort": {&message.ListMessagesInput{Sort: "title", Cursor: cursor}, "INVALID_CURSOR"},
+		"malformed cursor":      {&message.ListMessagesInput{Cursor: "not-a-cursor"}, "INVALID_CURSOR"},
+	}
+
+	for name, tc := range cases {
+		output, err := s.interactor.ListMessages(s.ctx, tc.input)
+
+		assert.Nil(s.T(), output, name)
+		appErr, ok := errx.IsAppError(err)
+		assert.True(s.T(), ok, name)
+		assert.Equal(s.T(), tc.code, appErr.Code, name)
+	}
+	s.mockRepo.AssertNotCalled(s.T(), "List")
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_Success() {
+	// テストデータ準備
+	messageID := uuid.New()
+	existingMessage := &model.Message{
+		ID:     messageID,
+		Title:  "誤字のあるタイトル",
+		Body:   "本文",
+		Status: model.MessageStatusScheduled,
+	}
+
+	newTitle := "修正後のタイトル"
+	input := &message.UpdateMessageInput{
+		ID:    messageID,
+		Title: &newTitle,
+		Actor: "editor@example.com",
+	}
+
+	// モックの期待値設定
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(existingMessage, nil).Once()
+
+	// タイトルのみ更新され、本文とステータスは維持される。操作者はリビジョンの変更者になる
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.ID == messageID && c.Title == newTitle && c.Body == "本文" && c.Status == model.MessageStatusScheduled &&
+			c.ChangedBy == "editor@example.com"
+	})).Return(nil).Once()
+
+	// テスト実行
+	output, err := s.interactor.UpdateMessage(s.ctx, input)
+
+	// アサーション
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), newTitle, output.Title)
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_ReschedulesInItsTimezone() {
+	messageID := uuid.New()
+	previous := s.clock.now.Add(time.Hour)
+	existingMessage := &model.Message{ID: messageID, Title: "告知", Body: "本文", Status: model.MessageStatusScheduled, ScheduledAt: &previous, Timezone: "Asia/Tokyo"}
+	scheduledAt := s.clock.now.Add(2 * time.Hour)
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(existingMessage, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.Status == model.MessageStatusScheduled && c.ScheduledAt.Equal(scheduledAt) && c.Timezone == "Asia/Tokyo"
+	})).Return(nil).Once()
+
+	_, err := s.interactor.UpdateMessage(s.ctx, &message.UpdateMessageInput{ID: messageID, ScheduledAt: &scheduledAt})
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_ScheduledAtRequiresScheduled() {
+	messageID := uuid.New()
+	draft := &model.Message{ID: messageID, Title: "下書き", Body: "本文", Status: model.MessageStatusDraft}
+	scheduledAt := s.clock.now.Add(time.Hour)
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(draft, nil).Once()
+
+	_, err := s.interactor.UpdateMessage(s.ctx, &message.UpdateMessageInput{ID: messageID, ScheduledAt: &scheduledAt})
+
+	// 下書きに日時だけ設定してもスケジューラの対象にならないため、配信予約を使う
+	var appErr *errx.AppError
+	assert.ErrorAs(s.T(), err, &appErr)
+	assert.Equal(s.T(), "NOT_SCHEDULED", appErr.Code)
+	s.mockRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_AlreadySent() {
+	// テストデータ準備（既に送信済みのメッセージ）
+	messageID := uuid.New()
+	sentMessage := &model.Message{
+		ID:     messageID,
+		Title:  "送信済みメッセージ",
+		Body:   "テストメッセージ",
+		Status: model.MessageStatusSent,
+	}
+
+	newBody := "送信後の修正"
+	input := &message.UpdateMessageInput{
+		ID:   messageID,
+		Body: &newBody,
+	}
+
+	// モックの期待値設定（Updateは呼ばれない）
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(sentMessage, nil).Once()
+
+	// テスト実行
+	output, err := s.interactor.UpdateMessage(s.ctx, input)
+
+	// アサーション
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), output)
+	if appErr, ok := err.(*errx.AppError); ok {
+		assert.Equal(s.T(), "CANNOT_EDIT", appErr.Code)
+		assert.Equal(s.T(), "Messages in sent status cannot be edited", appErr.Message)
+	}
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_EmptyBody() {
+	// 空の本文での更新はリポジトリに到達する前に弾かれる
+	emptyBody := ""
+	input := &message.UpdateMessageInput{
+		ID:   uuid.New(),
+		Body: &emptyBody,
+	}
+
+	// テスト実行
+	output, err := s.interactor.UpdateMessage(s.ctx, input)
+
+	// アサーション
+	assert.Equal(s.T(), errx.ErrInvalidInput, err)
+	assert.Nil(s.T(), output)
+}
+
+func (s *MessageInteractorTestSuite) TestScheduleMessage_Success() {
+	// テストデータ準備
+	messageID := uuid.New()
+	draftMessage := &model.Message{
+		ID:     messageID,
+		Title:  "配信予約テスト",
+		Body:   "テストメッセージ",
+		Status: model.MessageStatusDraft,
+	}
+
+	scheduledAt := s.clock.now.Add(3 * time.Hour)
+	input := &message.ScheduleMessageInput{
+		ID:          messageID,
+		ScheduledAt: scheduledAt,
+	}
+
+	// モックの期待値設定
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(draftMessage, nil).Once()
+
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.Status == model.MessageStatusScheduled && c.ScheduledAt != nil && c.ScheduledAt.Equal(scheduledAt)
+	})).Return(nil).Once()
+
+	// テスト実行
+	output, err := s.interactor.ScheduleMessage(s.ctx, input)
+
+	// アサーション
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.MessageStatusScheduled, output.Status)
+	assert.Equal(s.T(), model.DefaultWorkspaceTimezone, output.Timezone)
+}
+
+func (s *MessageInteractorTestSuite) TestScheduleMessage_LocalTime() {
+	// JSTのローカル日時で指定するとUTCに変換して保存される
+	messageID := uuid.New()
+	draftMessage := &model.Message{ID: messageID, Title: "配信予約テスト", Body: "本文", Status: model.MessageStatusDraft}
+	input := &message.ScheduleMessageInput{
+		ID:        messageID,
+		LocalTime: "2024-01-01T23:30",
+		Timezone:  "Asia/Tokyo",
+	}
+	expected := time.Date(2024, 1, 1, 14, 30, 0, 0, time.UTC)
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(draftMessage, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.ScheduledAt.Equal(expected) && c.Timezone == "Asia/Tokyo"
+	})).Return(nil).Once()
+
+	output, err := s.interactor.ScheduleMessage(s.ctx, input)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "2024-01-01T23:30:00+09:00", output.ScheduledAtLocal().Format(time.RFC3339))
+}
+
+func (s *MessageInteractorTestSuite) TestScheduleMessage_InvalidTimeInput() {
+	cases := map[string]struct {
+		input *message.ScheduleMessageInput
+		code  string
+	}{
+		"unknown timezone": {
+			input: &message.ScheduleMessageInput{LocalTime: "2024-02-01T20:00", Timezone: "Asia/Nowhere"},
+			code:  "INVALID_TIMEZONE",
+		},
+		"malformed local time": {
+			input: &message.ScheduleMessageInput{LocalTime: "2024/02/01 20:00", Timezone: "Asia/Tokyo"},
+			code:  "INVALID_LOCAL_TIME",
+		},
+		"skipped by DST": {
+			input: &message.ScheduleMessageInput{LocalTime: "2024-03-10T02:30", Timezone: "America/New_York"},
+			code:  "INVALID_LOCAL_TIME",
+		},
+		"both absolute and local": {
+			input: &message.ScheduleMessageInput{ScheduledAt: s.clock.now.Add(time.Hour), LocalTime: "2024-02-01T20:00"},
+			code:  "INVALID_INPUT",
+		},
+		"neither": {
+			input: &message.ScheduleMessageInput{Timezone: "Asia/Tokyo"},
+			code:  "INVALID_INPUT",
+		},
+	}
+
+	for name, tc := range cases {
+		tc.input.ID = uuid.New()
+		output, err := s.interactor.ScheduleMessage(s.ctx, tc.input)
+
+		assert.Nil(s.T(), output, name)
+		appErr, ok := errx.IsAppError(err)
+		assert.True(s.T(), ok, name)
+		assert.Equal(s.T(), tc.code, appErr.Code, name)
+	}
+}
+
+func (s *MessageInteractorTestSuite) TestScheduleMessage_PastTime() {
+	// 現在時刻以前の予約はリポジトリに到達する前に弾かれる
+	input := &message.ScheduleMessageInput{
+		ID:          uuid.New(),
+		ScheduledAt: s.clock.now.Add(-1 * time.Minute),
+	}
+
+	// テスト実行
+	output, err := s.interactor.ScheduleMessage(s.ctx, input)
+
+	// アサーション
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), output)
+	if appErr, ok := err.(*errx.AppError); ok {
+		assert.Equal(s.T(), "INVALID_SCHEDULE", appErr.Code)
+	}
+}
+
+func (s *MessageInteractorTestSuite) TestUnscheduleMessage_Success() {
+	// テストデータ準備
+	messageID := uuid.New()
+	scheduledAt := s.clock.now.Add(time.Hour)
+	scheduledMessage := &model.Message{
+		ID:          messageID,
+		Title:       "予約解除テスト",
+		Body:        "テストメッセージ",
+		Status:      model.MessageStatusScheduled,
+		ScheduledAt: &scheduledAt,
+	}
+
+	// モックの期待値設定
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(scheduledMessage, nil).Once()
+
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.Status == model.MessageStatusDraft && c.ScheduledAt == nil
+	})).Return(nil).Once()
+
+	// テスト実行
+	output, err := s.interactor.UnscheduleMessage(s.ctx, messageID)
+
+	// アサーション
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.MessageStatusDraft, output.Status)
+}
+
+func (s *MessageInteractorTestSuite) TestUnscheduleMessage_NotScheduled() {
+	// 予約されていないメッセージの解除はエラー
+	messageID := uuid.New()
+	draftMessage := &model.Message{
+		ID:     messageID,
+		Title:  "下書き",
+		Body:   "テストメッセージ",
+		Status: model.MessageStatusDraft,
+	}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(draftMessage, nil).Once()
+
+	// テスト実行
+	output, err := s.interactor.UnscheduleMessage(s.ctx, messageID)
+
+	// アサーション
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), output)
+	if appErr, ok := err.(*errx.AppError); ok {
+		assert.Equal(s.T(), "NOT_SCHEDULED", appErr.Code)
+	}
+}
+
+func (s *MessageInteractorTestSuite) TestCancelMessage_AlreadySent() {
+	// 送信済みメッセージは中止できない
+	messageID := uuid.New()
+	sentMessage := &model.Message{
+		ID:     messageID,
+		Title:  "送信済みメッセージ",
+		Body:   "テストメッセージ",
+		Status: model.MessageStatusSent,
+	}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(sentMessage, nil).Once()
+
+	// テスト実行
+	output, err := s.interactor.CancelMessage(s.ctx, messageID)
+
+	// アサーション
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), output)
+	if appErr, ok := err.(*errx.AppError); ok {
+		assert.Equal(s.T(), "CANNOT_CANCEL", appErr.Code)
+	}
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_WithImageContent() {
+	// 画像メッセージは本文なしでも作成できる
+	input := &message.CreateMessageInput{
+		Title: "新ビジュアル公開",
+		Content: &model.MessageContent{
+			Type: model.ContentTypeImage,
+			Image: &model.ImageContent{
+				OriginalContentURL: "https://example.com/visual.png",
+				PreviewImageURL:    "https://example.com/visual_preview.png",
+			},
+		},
+	}
+
+	s.mockRepo.EXPECT().Create(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.Content != nil && c.Content.Type == model.ContentTypeImage
+	})).Return(nil).Once()
+
+	// テスト実行
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	// アサーション
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.ContentTypeImage, output.Content.Type)
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_InvalidContent() {
+	// HTTPS以外の画像URLは作成前に弾かれる
+	input := &message.CreateMessageInput{
+		Title: "不正な画像",
+		Content: &model.MessageContent{
+			Type: model.ContentTypeImage,
+			Image: &model.ImageContent{
+				OriginalContentURL: "http://example.com/visual.png",
+				PreviewImageURL:    "http://example.com/visual_preview.png",
+			},
+		},
+	}
+
+	// テスト実行
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	// アサーション
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), output)
+	if appErr, ok := err.(*errx.AppError); ok {
+		assert.Equal(s.T(), "INVALID_CONTENT", appErr.Code)
+	}
 }
 
 // テストスイートを実行するためのエントリーポイント
+func (s *MessageInteractorTestSuite) TestCreateMessage_FromTemplate() {
+	template := model.NewMessageTemplate("週次告知", "{{week}}週目のお知らせ", "{{ name }}さん、今週は{{event}}です")
+	input := &message.CreateMessageInput{
+		TemplateID: &template.ID,
+		Variables:  map[string]string{"week": "3", "name": "山田", "event": "配信"},
+	}
+
+	s.mockTemplateRepo.EXPECT().FindByID(s.ctx, template.ID).Return(template, nil).Once()
+	s.mockRepo.EXPECT().Create(s.ctx, mock.AnythingOfType("*model.Message")).Return(nil).Once()
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "3週目のお知らせ", output.Title)
+	assert.Equal(s.T(), "山田さん、今週は配信です", output.Body)
+	assert.Equal(s.T(), &template.ID, output.TemplateID)
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_FromTemplateMissingVariables() {
+	template := model.NewMessageTemplate("週次告知", "{{week}}週目のお知らせ", "{{name}}さんへ")
+	input := &message.CreateMessageInput{
+		TemplateID: &template.ID,
+		Variables:  map[string]string{"week": "3"},
+	}
+
+	s.mockTemplateRepo.EXPECT().FindByID(s.ctx, template.ID).Return(template, nil).Once()
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.Nil(s.T(), output)
+	appErr, ok := errx.IsAppError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "MISSING_VARIABLES", appErr.Code)
+	assert.Contains(s.T(), appErr.Message, "name")
+	s.mockRepo.AssertNotCalled(s.T(), "Create")
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_TemplateWithLiteralTitle() {
+	// テンプレートとタイトル・本文の直接指定は併用不可
+	templateID := uuid.New()
+	input := &message.CreateMessageInput{
+		Title:      "直接指定",
+		TemplateID: &templateID,
+	}
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.Nil(s.T(), output)
+	assert.Equal(s.T(), errx.ErrInvalidInput, err)
+}
+
+func (s *MessageInteractorTestSuite) TestRunScheduler_ExpandsRecurringSchedules() {
+	// 毎日9時の繰り返し配信（前日作成、初回は期限到来済み）
+	source := model.NewMessage("朝の配信", "おはようございます")
+	schedule, err := model.NewRecurringSchedule(source.ID, "0 9 * * *", "UTC", time.Time{}, s.clock.now.AddDate(0, 0, -1))
+	assert.NoError(s.T(), err)
+	occurrenceAt := *schedule.NextRunAt
+
+	s.mockScheduleRepo.EXPECT().FindDueSchedules(s.ctx, s.clock.now, 10).Return([]*model.RecurringSchedule{schedule}, nil).Once()
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, source.ID).Return(source, nil).Once()
+
+	// 雛形を複製した予約メッセージが作成される
+	s.mockRepo.EXPECT().Create(s.ctx, mock.MatchedBy(func(m *model.Message) bool {
+		return m.ID != source.ID && m.Title == source.Title && m.Status == model.MessageStatusScheduled && m.ScheduledAt.Equal(occurrenceAt)
+	})).Return(nil).Once()
+	s.mockScheduleRepo.EXPECT().AdvanceIfDue(s.ctx, schedule, occurrenceAt).Return(true, nil).Once()
+	s.mockBatchRepo.EXPECT().FindAccepted(s.ctx, 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindStuckSending(s.ctx, s.clock.now.Add(-15*time.Minute), 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindScheduledMessages(s.ctx, s.clock.now, 10).Return([]*model.Message{}, nil).Once()
+
+	sent, err := s.interactor.RunScheduler(s.ctx, &message.SchedulerInput{Now: s.clock.now, Limit: 10})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &message.SchedulerResult{}, sent)
+	assert.Equal(s.T(), occurrenceAt, *schedule.LastRunAt)
+	assert.Equal(s.T(), time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), *schedule.NextRunAt)
+	assert.Equal(s.T(), model.MessageStatusDraft, source.Status)
+}
+
+func (s *MessageInteractorTestSuite) TestRunScheduler_SkipsScheduleExpandedConcurrently() {
+	source := model.NewMessage("朝の配信", "おはようございます")
+	schedule, err := model.NewRecurringSchedule(source.ID, "0 9 * * *", "UTC", time.Time{}, s.clock.now.AddDate(0, 0, -1))
+	assert.NoError(s.T(), err)
+	occurrenceAt := *schedule.NextRunAt
+
+	s.mockScheduleRepo.EXPECT().FindDueSchedules(s.ctx, s.clock.now, 10).Return([]*model.RecurringSchedule{schedule}, nil).Once()
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, source.ID).Return(source, nil).Once()
+	// 同時に実行されたスケジューラが先に次回日時を進めている
+	s.mockScheduleRepo.EXPECT().AdvanceIfDue(s.ctx, schedule, occurrenceAt).Return(false, nil).Once()
+	s.mockBatchRepo.EXPECT().FindAccepted(s.ctx, 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindStuckSending(s.ctx, s.clock.now.Add(-15*time.Minute), 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindScheduledMessages(s.ctx, s.clock.now, 10).Return([]*model.Message{}, nil).Once()
+
+	_, err = s.interactor.RunScheduler(s.ctx, &message.SchedulerInput{Now: s.clock.now, Limit: 10})
+
+	assert.NoError(s.T(), err)
+	s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *MessageInteractorTestSuite) TestListRevisions_WithChangedFields() {
+	messageID := uuid.New()
+	current := &model.Message{ID: messageID, Title: "改訂", Body: "本文", Status: model.MessageStatusScheduled}
+	scheduledAt := s.clock.now.Add(time.Hour)
+	revisions := []*model.MessageRevision{
+		{MessageID: messageID, Revision: 3, Title: "改訂", Body: "本文", Status: model.MessageStatusScheduled, ScheduledAt: &scheduledAt, Timezone: "Asia/Tokyo"},
+		{MessageID: messageID, Revision: 2, Title: "改訂", Body: "本文", Status: model.MessageStatusDraft},
+		{MessageID: messageID, Revision: 1, Title: "初版", Body: "本文", Status: model.MessageStatusDraft},
+	}
+
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(current, nil).Once()
+	s.mockRepo.EXPECT().ListRevisions(s.ctx, messageID).Return(revisions, nil).Once()
+
+	output, err := s.interactor.ListRevisions(s.ctx, messageID)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{"status", "scheduled_at"}, output[0].ChangedFields)
+	assert.Equal(s.T(), []string{"title"}, output[1].ChangedFields)
+	assert.Empty(s.T(), output[2].ChangedFields)
+}
+
+func (s *MessageInteractorTestSuite) TestRestoreRevision_UnschedulesIntoDraft() {
+	messageID := uuid.New()
+	scheduledAt := s.clock.now.Add(time.Hour)
+	scheduled := &model.Message{ID: messageID, Title: "改訂", Body: "改訂本文", Status: model.MessageStatusScheduled, ScheduledAt: &scheduledAt, Timezone: "Asia/Tokyo"}
+	revision := &model.MessageRevision{MessageID: messageID, Revision: 1, Title: "初版", Body: "初版本文", Status: model.MessageStatusDraft}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(scheduled, nil).Once()
+	s.mockRepo.EXPECT().FindRevision(s.ctx, messageID, 1).Return(revision, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(c *model.Message) bool {
+		return c.Title == "初版" && c.Body == "初版本文" && c.Status == model.MessageStatusDraft && c.ScheduledAt == nil && c.Timezone == ""
+	})).Return(nil).Once()
+
+	output, err := s.interactor.RestoreRevision(s.ctx, &message.RestoreRevisionInput{ID: messageID, Revision: 1})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.MessageStatusDraft, output.Status)
+}
+
+func (s *MessageInteractorTestSuite) TestRestoreRevision_DeletedDraft() {
+	messageID := uuid.New()
+	deletedAt := s.clock.now.Add(-time.Hour)
+	// 下書きのままゴミ箱にある場合もステータス遷移を経ずに復元しない
+	draft := &model.Message{ID: messageID, Title: "下書き", Body: "本文", Status: model.MessageStatusDraft, DeletedAt: &deletedAt}
+	revision := &model.MessageRevision{MessageID: messageID, Revision: 1, Title: "初版", Body: "本文"}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(draft, nil).Once()
+	s.mockRepo.EXPECT().FindRevision(s.ctx, messageID, 1).Return(revision, nil).Once()
+
+	output, err := s.interactor.RestoreRevision(s.ctx, &message.RestoreRevisionInput{ID: messageID, Revision: 1})
+
+	assert.Nil(s.T(), output)
+	appErr, ok := errx.IsAppError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "MESSAGE_DELETED", appErr.Code)
+	assert.Equal(s.T(), "下書き", draft.Title)
+	s.mockRepo.AssertNotCalled(s.T(), "Update")
+}
+
+func (s *MessageInteractorTestSuite) TestRestoreRevision_AlreadySent() {
+	messageID := uuid.New()
+	sent := &model.Message{ID: messageID, Title: "送信済み", Body: "本文", Status: model.MessageStatusSent}
+	revision := &model.MessageRevision{MessageID: messageID, Revision: 1, Title: "初版", Body: "本文"}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(sent, nil).Once()
+	s.mockRepo.EXPECT().FindRevision(s.ctx, messageID, 1).Return(revision, nil).Once()
+
+	output, err := s.interactor.RestoreRevision(s.ctx, &message.RestoreRevisionInput{ID: messageID, Revision: 1})
+
+	assert.Nil(s.T(), output)
+	appErr, ok := errx.IsAppError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "CANNOT_RESTORE", appErr.Code)
+	s.mockRepo.AssertNotCalled(s.T(), "Update")
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_WithParts() {
+	input := &message.CreateMessageInput{
+		Title: "配信告知",
+		Parts: model.MessageParts{
+			{Type: model.ContentTypeImage, Image: &model.ImageContent{OriginalContentURL: "https://example.com/a.png", PreviewImageURL: "https://example.com/a_s.png"}},
+			{Type: model.ContentTypeText, Text: &model.TextContent{Text: "今夜21時から"}},
+		},
+	}
+
+	s.mockRepo.EXPECT().Create(s.ctx, mock.MatchedBy(func(m *model.Message) bool {
+		return len(m.Parts) == 2 && m.Content == nil
+	})).Return(nil).Once()
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.ContentTypeImage, output.Contents()[0].Type)
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_ContentAndPartsTogether() {
+	text := model.MessageContent{Type: model.ContentTypeText, Text: &model.TextContent{Text: "本文"}}
+	input := &message.CreateMessageInput{
+		Title:   "配信告知",
+		Content: &text,
+		Parts:   model.MessageParts{text},
+	}
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.Nil(s.T(), output)
+	assert.Equal(s.T(), errx.ErrInvalidInput, err)
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_FieldErrors() {
+	input := &message.CreateMessageInput{
+		Title: "配信告知",
+		Body:  strings.Repeat("😀", model.TextMaxLength/2),
+	}
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.Nil(s.T(), output)
+	appErr, ok := errx.IsAppError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "INVALID_CONTENT", appErr.Code)
+	assert.Equal(s.T(), []errx.FieldError{{Field: "body", Message: "must be at most 5000 characters including the title"}}, appErr.Fields)
+	s.mockRepo.AssertNotCalled(s.T(), "Create")
+}
+
+func (s *MessageInteractorTestSuite) TestScheduleMessage_RejectsOversizedMessage() {
+	messageID := uuid.New()
+	// 制限導入前に保存された長すぎる本文
+	existing := &model.Message{ID: messageID, Title: "告知", Body: strings.Repeat("a", model.TextMaxLength), Status: model.MessageStatusDraft}
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(existing, nil).Once()
+
+	output, err := s.interactor.ScheduleMessage(s.ctx, &message.ScheduleMessageInput{ID: messageID, ScheduledAt: s.clock.now.Add(time.Hour)})
+
+	assert.Nil(s.T(), output)
+	appErr, ok := errx.IsAppError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "INVALID_CONTENT", appErr.Code)
+	s.mockRepo.AssertNotCalled(s.T(), "Update")
+}
+
+func (s *MessageInteractorTestSuite) TestSearchMessages_Success() {
+	hit := &model.MessageSearchResult{Message: model.NewMessage("夏のライブ告知", "8月に開催します"), Rank: 1.5}
+	s.mockRepo.EXPECT().Search(s.ctx, []string{"夏", "ライブ"}, 20, 0).Return([]*model.MessageSearchResult{hit}, nil).Once()
+
+	results, err := s.interactor.SearchMessages(s.ctx, &message.SearchMessagesInput{Query: "夏　ライブ", Limit: 20})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), results, 1)
+	assert.Equal(s.T(), "<mark>夏</mark>の<mark>ライブ</mark>告知", results[0].TitleHighlight)
+}
+
+func (s *MessageInteractorTestSuite) TestSearchMessages_EmptyQuery() {
+	results, err := s.interactor.SearchMessages(s.ctx, &message.SearchMessagesInput{Query: "  "})
+
+	assert.Nil(s.T(), results)
+	assert.Equal(s.T(), errx.ErrInvalidInput, err)
+	s.mockRepo.AssertNotCalled(s.T(), "Search")
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_WithTags() {
+	stream, _ := model.NewTag("配信予定", "")
+	merch, _ := model.NewTag("グッズ", "#ff8800")
+	input := &message.CreateMessageInput{
+		Title:  "新グッズ",
+		Body:   "明日から販売開始",
+		TagIDs: []uuid.UUID{stream.ID, merch.ID},
+	}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockTagRepo.EXPECT().FindByIDs(s.ctx, input.TagIDs).Return([]*model.Tag{merch, stream}, nil).Once()
+	s.mockRepo.EXPECT().Create(s.ctx, mock.AnythingOfType("*model.Message")).Return(nil).Once()
+	s.mockRepo.EXPECT().SetTags(s.ctx, mock.AnythingOfType("uuid.UUID"), []uuid.UUID{stream.ID, merch.ID}).Return(nil).Once()
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*model.Tag{stream, merch}, output.Tags)
+}
+
+func (s *MessageInteractorTestSuite) TestCreateMessage_UnknownTag() {
+	input := &message.CreateMessageInput{Title: "告知", Body: "本文", TagIDs: []uuid.UUID{uuid.New()}}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockTagRepo.EXPECT().FindByIDs(s.ctx, input.TagIDs).Return([]*model.Tag{}, nil).Once()
+
+	output, err := s.interactor.CreateMessage(s.ctx, input)
+
+	assert.Nil(s.T(), output)
+	appErr, ok := errx.IsAppError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "TAG_NOT_FOUND", appErr.Code)
+	s.mockRepo.AssertNotCalled(s.T(), "Create")
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_ClearsTags() {
+	messageID := uuid.New()
+	tag, _ := model.NewTag("コラボ", "")
+	existing := &model.Message{ID: messageID, Title: "告知", Body: "本文", Status: model.MessageStatusDraft, Tags: []*model.Tag{tag}}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, messageID).Return(existing, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, existing).Return(nil).Once()
+	s.mockRepo.EXPECT().SetTags(s.ctx, messageID, []uuid.UUID{}).Return(nil).Once()
+
+	// 空配列はタグを全て外す（nil の場合は変更しない）
+	output, err := s.interactor.UpdateMessage(s.ctx, &message.UpdateMessageInput{ID: messageID, TagIDs: []uuid.UUID{}})
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), output.Tags)
+	s.mockTagRepo.AssertNotCalled(s.T(), "FindByIDs")
+}
+
+func (s *MessageInteractorTestSuite) TestDuplicateMessage_Success() {
+	sentAt := s.clock.now.Add(-time.Hour)
+	tag, _ := model.NewTag("配信予定", "")
+	source := &model.Message{
+		ID:          uuid.New(),
+		Title:       "週間スケジュール",
+		Body:        "今週の配信予定です",
+		Parts:       model.MessageParts{{Type: model.ContentTypeText, Text: &model.TextContent{Text: "月曜 21時"}}},
+		Tags:        []*model.Tag{tag},
+		Status:      model.MessageStatusSent,
+		ScheduledAt: &sentAt,
+		Timezone:    "Asia/Tokyo",
+		SentAt:      &sentAt,
+	}
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, source.ID).Return(source, nil).Once()
+	s.mockRepo.EXPECT().Create(s.ctx, mock.AnythingOfType("*model.Message")).Return(nil).Once()
+	s.mockRepo.EXPECT().SetTags(s.ctx, mock.AnythingOfType("uuid.UUID"), []uuid.UUID{tag.ID}).Return(nil).Once()
+
+	output, err := s.interactor.DuplicateMessage(s.ctx, source.ID)
+
+	// 内容・タグは複製され、配信状態は引き継がない
+	assert.NoError(s.T(), err)
+	assert.NotEqual(s.T(), source.ID, output.ID)
+	assert.Equal(s.T(), &source.ID, output.SourceMessageID)
+	assert.Equal(s.T(), model.MessageStatusDraft, output.Status)
+	assert.Equal(s.T(), source.Title, output.Title)
+	assert.Equal(s.T(), source.Parts, output.Parts)
+	assert.Equal(s.T(), source.Tags, output.Tags)
+	assert.Nil(s.T(), output.ScheduledAt)
+	assert.Nil(s.T(), output.SentAt)
+	assert.Empty(s.T(), output.Timezone)
+}
+
+func (s *MessageInteractorTestSuite) TestDuplicateMessage_NotFound() {
+	id := uuid.New()
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, id).Return(nil, fmt.Errorf("message not found")).Once()
+
+	output, err := s.interactor.DuplicateMessage(s.ctx, id)
+
+	assert.Nil(s.T(), output)
+	assert.Equal(s.T(), errx.ErrNotFound, err)
+	s.mockRepo.AssertNotCalled(s.T(), "Create")
+}
+
+func (s *MessageInteractorTestSuite) TestDeleteMessage_Success() {
+	msg := model.NewMessage("テスト", "本文")
+	assert.NoError(s.T(), msg.Schedule(s.clock.now.Add(time.Hour)))
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, msg).Return(nil).Once()
+
+	err := s.interactor.DeleteMessage(s.ctx, msg.ID)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), s.clock.now, *msg.DeletedAt)
+	assert.Equal(s.T(), model.MessageStatusScheduled, msg.Status)
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_Deleted() {
+	msg := model.NewMessage("テスト", "本文")
+	assert.NoError(s.T(), msg.Delete(s.clock.now))
+	title := "新しいタイトル"
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, msg.ID).Return(msg, nil).Once()
+
+	output, err := s.interactor.UpdateMessage(s.ctx, &message.UpdateMessageInput{ID: msg.ID, Title: &title})
+
+	assert.Nil(s.T(), output)
+	var appErr *errx.AppError
+	assert.ErrorAs(s.T(), err, &appErr)
+	assert.Equal(s.T(), "MESSAGE_DELETED", appErr.Code)
+	s.mockRepo.AssertNotCalled(s.T(), "Update")
+}
+
+func (s *MessageInteractorTestSuite) TestRestoreMessage_NotDeleted() {
+	msg := model.NewMessage("テスト", "本文")
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, msg.ID).Return(msg, nil).Once()
+
+	output, err := s.interactor.RestoreMessage(s.ctx, msg.ID)
+
+	assert.Nil(s.T(), output)
+	var appErr *errx.AppError
+	assert.ErrorAs(s.T(), err, &appErr)
+	assert.Equal(s.T(), "NOT_DELETED", appErr.Code)
+}
+
+func (s *MessageInteractorTestSuite) TestListMessages_TrashDefaultsToDeletedAtSort() {
+	s.mockRepo.EXPECT().List(s.ctx, mock.MatchedBy(func(q *model.MessageQuery) bool {
+		return q.Filter.Deleted && q.Sort == model.DefaultTrashSort
+	})).Return([]*model.Message{}, nil).Once()
+	s.mockRepo.EXPECT().Count(s.ctx, mock.MatchedBy(func(f *model.MessageFilter) bool {
+		return f.Deleted
+	})).Return(0, nil).Once()
+
+	page, err := s.interactor.ListMessages(s.ctx, &message.ListMessagesInput{Deleted: true})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, page.Total)
+}
+
+func (s *MessageInteractorTestSuite) TestRunScheduler_PausesScheduleOfDeletedSource() {
+	source := model.NewMessage("朝の配信", "おはようございます")
+	assert.NoError(s.T(), source.Delete(s.clock.now.Add(-time.Hour)))
+	schedule, err := model.NewRecurringSchedule(source.ID, "0 9 * * *", "UTC", time.Time{}, s.clock.now.AddDate(0, 0, -1))
+	assert.NoError(s.T(), err)
+
+	s.mockScheduleRepo.EXPECT().FindDueSchedules(s.ctx, s.clock.now, 10).Return([]*model.RecurringSchedule{schedule}, nil).Once()
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, source.ID).Return(source, nil).Once()
+	s.mockScheduleRepo.EXPECT().PauseIfDue(s.ctx, schedule, *schedule.NextRunAt).Return(true, nil).Once()
+	s.mockBatchRepo.EXPECT().FindAccepted(s.ctx, 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindStuckSending(s.ctx, s.clock.now.Add(-15*time.Minute), 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindScheduledMessages(s.ctx, s.clock.now, 10).Return([]*model.Message{}, nil).Once()
+
+	_, err = s.interactor.RunScheduler(s.ctx, &message.SchedulerInput{Now: s.clock.now, Limit: 10})
+
+	// ゴミ箱にある雛形からは予約メッセージを作らない
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.RecurringScheduleStatusPaused, schedule.Status)
+	s.mockRepo.AssertNotCalled(s.T(), "Create")
+}
+
+func (s *MessageInteractorTestSuite) TestSendMessage_MulticastPartialFailure() {
+	msg := model.NewMessage("告知", "本文")
+	assert.NoError(s.T(), msg.SetRecipients(lineUserIDs(1200)))
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, msg.ID).Return(nil, nil).Once()
+
+	var created []*model.DeliveryBatch
+	s.mockBatchRepo.EXPECT().CreateBatches(s.ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, batches []*model.DeliveryBatch) error {
+			created = batches
+			return nil
+		}).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusSending
+	})).Return(nil).Once()
+
+	// 2番目のバッチだけ失敗する
+	s.mockPusher.EXPECT().Multicast(s.ctx, msg, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, m *model.Message, to model.Recipients, retryKey uuid.UUID) (string, error) {
+			if retryKey == created[1].ID {
+				return "", fmt.Errorf("LINE API error: status 500")
+			}
+			return "req-" + retryKey.String(), nil
+		}).Times(3)
+	s.mockBatchRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.Anything).Return(nil).Times(3)
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusFailed
+	})).Return(nil).Once()
+
+	err := s.interactor.SendMessage(s.ctx, &message.SendMessageInput{ID: msg.ID})
+
+	var appErr *errx.AppError
+	assert.ErrorAs(s.T(), err, &appErr)
+	assert.Equal(s.T(), "PUSH_FAILED", appErr.Code)
+	assert.Len(s.T(), created, 3)
+	assert.Len(s.T(), created[2].Recipients, 200)
+	assert.True(s.T(), created[0].IsSucceeded())
+	assert.Equal(s.T(), model.DeliveryBatchStatusFailed, created[1].Status)
+	assert.True(s.T(), created[2].IsSucceeded())
+}
+
+func (s *MessageInteractorTestSuite) TestSendMessage_RetrySkipsSucceededBatches() {
+	msg := model.NewMessage("告知", "本文")
+	assert.NoError(s.T(), msg.SetRecipients(lineUserIDs(600)))
+	msg.Status = model.MessageStatusFailed
+	batches := model.NewDeliveryBatches(msg.ID, msg.Recipients, s.clock.now)
+	batches[0].MarkAsSucceeded("req-0", s.clock.now)
+	batches[1].MarkAsFailed("LINE API error: status 500", s.clock.now)
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, msg.ID).Return(batches, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusSending
+	})).Return(nil).Once()
+
+	// 失敗したバッチだけを同じ再送キーで送る
+	s.mockPusher.EXPECT().Multicast(s.ctx, msg, batches[1].Recipients, batches[1].ID).Return("req-1", nil).Once()
+	s.mockBatchRepo.EXPECT().Update(context.WithoutCancel(s.ctx), batches[1]).Return(nil).Once()
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusSent
+	})).Return(nil).Once()
+
+	err := s.interactor.SendMessage(s.ctx, &message.SendMessageInput{ID: msg.ID})
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), batches[1].IsSucceeded())
+	assert.Equal(s.T(), 2, batches[1].Attempts)
+	s.mockBatchRepo.AssertNotCalled(s.T(), "CreateBatches", mock.Anything, mock.Anything)
+}
+
+func (s *MessageInteractorTestSuite) TestSendMessage_CommitsBatchesBeforeSending() {
+	msg := model.NewMessage("告知", "本文")
+	assert.NoError(s.T(), msg.SetRecipients(lineUserIDs(600)))
+
+	committed := false
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			committed = err == nil
+			return err
+		}).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, msg.ID).Return(nil, nil).Once()
+	s.mockBatchRepo.EXPECT().CreateBatches(s.ctx, mock.Anything).Return(nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusSending
+	})).Return(nil).Once()
+
+	// LINEへの送信はバッチと送信中の状態をコミットした後に行う
+	s.mockPusher.EXPECT().Multicast(s.ctx, msg, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, m *model.Message, to model.Recipients, retryKey uuid.UUID) (string, error) {
+			assert.True(s.T(), committed)
+			return "req-" + retryKey.String(), nil
+		}).Once()
+	// 結果の記録に失敗した時点で送信を止め、失敗として記録する（成功済みのバッチの記録はロールバックされない）
+	s.mockBatchRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.Anything).Return(fmt.Errorf("connection reset")).Once()
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusFailed
+	})).Return(fmt.Errorf("connection reset")).Once()
+
+	err := s.interactor.SendMessage(s.ctx, &message.SendMessageInput{ID: msg.ID})
+
+	assert.Equal(s.T(), errx.ErrInternalServer, err)
+}
+
+func (s *MessageInteractorTestSuite) TestUpdateMessage_RecipientsLockedAfterDelivery() {
+	msg := model.NewMessage("告知", "本文")
+	assert.NoError(s.T(), msg.SetRecipients(lineUserIDs(2)))
+	msg.Status = model.MessageStatusFailed
+	batches := model.NewDeliveryBatches(msg.ID, msg.Recipients, s.clock.now)
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByID(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, msg.ID).Return(batches, nil).Once()
+
+	output, err := s.interactor.UpdateMessage(s.ctx, &message.UpdateMessageInput{ID: msg.ID, Recipients: lineUserIDs(3)})
+
+	assert.Nil(s.T(), output)
+	var appErr *errx.AppError
+	assert.ErrorAs(s.T(), err, &appErr)
+	assert.Equal(s.T(), "RECIPIENTS_LOCKED", appErr.Code)
+	s.mockRepo.AssertNotCalled(s.T(), "Update")
+}
+
+func (s *MessageInteractorTestSuite) TestSendMessage_BroadcastAsSingleBatch() {
+	msg := model.NewMessage("全員へのお知らせ", "本文")
+	assert.NoError(s.T(), msg.SetTarget(model.DeliveryTargetBroadcast))
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusSending
+	})).Return(nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, msg.ID).Return(nil, nil).Once()
+	s.mockPusher.EXPECT().CountReachableFollowers(s.ctx).Return(800, nil).Once()
+	s.mockPusher.EXPECT().GetQuota(s.ctx).Return(&model.MessageQuota{Limited: true, Limit: 1000, Used: 100}, nil).Once()
+
+	var created []*model.DeliveryBatch
+	s.mockBatchRepo.EXPECT().CreateBatches(s.ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, batches []*model.DeliveryBatch) error {
+			created = batches
+			return nil
+		}).Once()
+	s.mockPusher.EXPECT().Broadcast(s.ctx, msg, mock.AnythingOfType("uuid.UUID")).Return("req-1", nil).Once()
+	s.mockBatchRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.Anything).Return(nil).Once()
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusSent
+	})).Return(nil).Once()
+
+	err := s.interactor.SendMessage(s.ctx, &message.SendMessageInput{ID: msg.ID})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), created, 1)
+	assert.Equal(s.T(), model.DeliveryTargetBroadcast, created[0].Target)
+	assert.Equal(s.T(), 800, created[0].Audience)
+	assert.Equal(s.T(), "req-1", created[0].RequestID)
+	s.mockPusher.AssertNotCalled(s.T(), "Multicast", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *MessageInteractorTestSuite) TestSendMessage_BroadcastQuotaExceeded() {
+	msg := model.NewMessage("全員へのお知らせ", "本文")
+	assert.NoError(s.T(), msg.SetTarget(model.DeliveryTargetBroadcast))
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusSending
+	})).Return(nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, msg.ID).Return(nil, nil).Once()
+	s.mockPusher.EXPECT().CountReachableFollowers(s.ctx).Return(800, nil).Once()
+	s.mockPusher.EXPECT().GetQuota(s.ctx).Return(&model.MessageQuota{Limited: true, Limit: 1000, Used: 500}, nil).Once()
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(m *model.Message) bool {
+		return m.Status == model.MessageStatusFailed
+	})).Return(nil).Once()
+
+	err := s.interactor.SendMessage(s.ctx, &message.SendMessageInput{ID: msg.ID})
+
+	// 残り送信数を超える場合は送信せず、バッチも作らない
+	var appErr *errx.AppError
+	assert.ErrorAs(s.T(), err, &appErr)
+	assert.Equal(s.T(), "QUOTA_EXCEEDED", appErr.Code)
+	s.mockPusher.AssertNotCalled(s.T(), "Broadcast", mock.Anything, mock.Anything, mock.Anything)
+	s.mockBatchRepo.AssertNotCalled(s.T(), "CreateBatches", mock.Anything, mock.Anything)
+}
+
+func (s *MessageInteractorTestSuite) TestGetDeliveryUsage_SumsSinceMonthStart() {
+	quota := &model.MessageQuota{Limited: true, Limit: 1000, Used: 300}
+	s.mockPusher.EXPECT().GetQuota(s.ctx).Return(quota, nil).Once()
+
+	// 既定のタイムゾーン（Asia/Tokyo）の月初から集計する
+	monthStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	s.mockBatchRepo.EXPECT().SumAudience(s.ctx, mock.MatchedBy(func(since time.Time) bool {
+		return since.Equal(monthStart)
+	})).Return(map[model.DeliveryTarget]int{model.DeliveryTargetBroadcast: 250, model.DeliveryTargetRecipients: 40}, nil).Once()
+
+	usage, err := s.interactor.GetDeliveryUsage(s.ctx)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), quota, usage.Quota)
+	assert.Equal(s.T(), 250, usage.ByTarget[model.DeliveryTargetBroadcast])
+}
+
+func (s *MessageInteractorTestSuite) TestSendMessage_NarrowcastStaysSending() {
+	msg := model.NewMessage("東京のファン向け", "本文")
+	assert.NoError(s.T(), msg.SetTarget(model.DeliveryTargetNarrowcast))
+	assert.NoError(s.T(), msg.SetNarrowcast(&model.NarrowcastFilter{Areas: []string{"jp_13"}}))
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, msg.ID).Return(msg, nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, msg.ID).Return(nil, nil).Once()
+	s.mockBatchRepo.EXPECT().CreateBatches(s.ctx, mock.Anything).Return(nil).Once()
+	s.mockPusher.EXPECT().Narrowcast(s.ctx, msg, mock.AnythingOfType("uuid.UUID")).Return("req-1", nil).Once()
+	s.mockBatchRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.MatchedBy(func(b *model.DeliveryBatch) bool {
+		return b.Status == model.DeliveryBatchStatusAccepted && b.RequestID == "req-1"
+	})).Return(nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, msg).Return(nil).Once()
+
+	err := s.interactor.SendMessage(s.ctx, &message.SendMessageInput{ID: msg.ID})
+
+	// 送信完了は進捗の確認で反映する
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.MessageStatusSending, msg.Status)
+	assert.Nil(s.T(), msg.SentAt)
+}
+
+func (s *MessageInteractorTestSuite) TestRunScheduler_CompletesNarrowcasts() {
+	done := model.NewMessage("完了", "本文")
+	assert.NoError(s.T(), done.MarkAsSending())
+	doneBatch := model.NewNarrowcastBatch(done.ID, s.clock.now)
+	doneBatch.MarkAsAccepted("req-done", s.clock.now)
+	running := model.NewNarrowcastBatch(uuid.New(), s.clock.now)
+	running.MarkAsAccepted("req-running", s.clock.now)
+
+	s.mockScheduleRepo.EXPECT().FindDueSchedules(s.ctx, s.clock.now, 10).Return(nil, nil).Once()
+	s.mockBatchRepo.EXPECT().FindAccepted(s.ctx, 10).Return([]*model.DeliveryBatch{doneBatch, running}, nil).Once()
+	s.mockPusher.EXPECT().GetNarrowcastProgress(s.ctx, "req-done").Return(&model.NarrowcastProgress{
+		Phase: model.NarrowcastPhaseSucceeded, TargetCount: 120, SuccessCount: 118, FailureCount: 2,
+	}, nil).Once()
+	s.mockPusher.EXPECT().GetNarrowcastProgress(s.ctx, "req-running").Return(&model.NarrowcastProgress{Phase: model.NarrowcastPhaseSending}, nil).Once()
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, done.ID).Return(done, nil).Once()
+	s.mockBatchRepo.EXPECT().Update(s.ctx, doneBatch).Return(nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, done).Return(nil).Once()
+	s.mockRepo.EXPECT().FindStuckSending(s.ctx, s.clock.now.Add(-15*time.Minute), 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindScheduledMessages(s.ctx, s.clock.now, 10).Return([]*model.Message{}, nil).Once()
+
+	result, err := s.interactor.RunScheduler(s.ctx, &message.SchedulerInput{Now: s.clock.now, Limit: 10})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &message.SchedulerResult{Sent: 1}, result)
+	assert.Equal(s.T(), model.MessageStatusSent, done.Status)
+	assert.Equal(s.T(), &model.DeliveryCounts{Target: 120, Success: 118, Failure: 2}, done.DeliveryCounts)
+	assert.True(s.T(), doneBatch.IsSucceeded())
+	assert.Equal(s.T(), 118, doneBatch.Audience)
+	assert.Equal(s.T(), model.DeliveryBatchStatusAccepted, running.Status)
+}
+
+func (s *MessageInteractorTestSuite) TestRunScheduler_FailsStuckSending() {
+	stuck := model.NewMessage("途中で終了", "本文")
+	assert.NoError(s.T(), stuck.MarkAsSending())
+	// 一覧の取得後、ロックを待つ間に送信結果が記録された
+	finished := model.NewMessage("完了済み", "本文")
+	assert.NoError(s.T(), finished.MarkAsSending())
+	assert.NoError(s.T(), finished.MarkAsSent())
+
+	s.mockScheduleRepo.EXPECT().FindDueSchedules(s.ctx, s.clock.now, 10).Return(nil, nil).Once()
+	s.mockBatchRepo.EXPECT().FindAccepted(s.ctx, 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindStuckSending(s.ctx, s.clock.now.Add(-15*time.Minute), 10).
+		Return([]*model.Message{{ID: stuck.ID}, {ID: finished.ID}}, nil).Once()
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Twice()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, stuck.ID).Return(stuck, nil).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, finished.ID).Return(finished, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, stuck).Return(nil).Once()
+	s.mockRepo.EXPECT().FindScheduledMessages(s.ctx, s.clock.now, 10).Return([]*model.Message{}, nil).Once()
+
+	_, err := s.interactor.RunScheduler(s.ctx, &message.SchedulerInput{Now: s.clock.now, Limit: 10})
+
+	// 失敗にしたメッセージは再送でき、作成済みのバッチで未送信の分だけ送る
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.MessageStatusFailed, stuck.Status)
+	assert.Equal(s.T(), model.MessageStatusSent, finished.Status)
+}
+
+func (s *MessageInteractorTestSuite) TestRunScheduler_CountsAcceptedNarrowcastsSeparately() {
+	push := model.NewMessage("お知らせ", "本文")
+	assert.NoError(s.T(), push.Schedule(s.clock.now.Add(-time.Minute)))
+	narrowcast := model.NewMessage("東京のファン向け", "本文")
+	assert.NoError(s.T(), narrowcast.SetTarget(model.DeliveryTargetNarrowcast))
+	assert.NoError(s.T(), narrowcast.SetNarrowcast(&model.NarrowcastFilter{Areas: []string{"jp_13"}}))
+	assert.NoError(s.T(), narrowcast.Schedule(s.clock.now.Add(-time.Minute)))
+
+	s.mockScheduleRepo.EXPECT().FindDueSchedules(s.ctx, s.clock.now, 10).Return(nil, nil).Once()
+	s.mockBatchRepo.EXPECT().FindAccepted(s.ctx, 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindStuckSending(s.ctx, s.clock.now.Add(-15*time.Minute), 10).Return(nil, nil).Once()
+	s.mockRepo.EXPECT().FindScheduledMessages(s.ctx, s.clock.now, 10).Return([]*model.Message{push, narrowcast}, nil).Once()
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Twice()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, push.ID).Return(push, nil).Once()
+	s.mockRepo.EXPECT().FindByIDForUpdate(s.ctx, narrowcast.ID).Return(narrowcast, nil).Once()
+	s.mockRepo.EXPECT().Update(s.ctx, mock.Anything).Return(nil).Twice()
+	s.mockPusher.EXPECT().PushMessage(s.ctx, push).Return(nil).Once()
+	s.mockRepo.EXPECT().Update(context.WithoutCancel(s.ctx), push).Return(nil).Once()
+	s.mockBatchRepo.EXPECT().ListByMessage(s.ctx, narrowcast.ID).Return(nil, nil).Once()
+	s.mockBatchRepo.EXPECT().CreateBatches(s.ctx, mock.Anything).Return(nil).Once()
+	s.mockPusher.EXPECT().Narrowcast(s.ctx, narrowcast, mock.AnythingOfType("uuid.UUID")).Return("req-1", nil).Once()
+	s.mockBatchRepo.EXPECT().Update(context.WithoutCancel(s.ctx), mock.Anything).Return(nil).Once()
+
+	result, err := s.interactor.RunScheduler(s.ctx, &message.SchedulerInput{Now: s.clock.now, Limit: 10})
+
+	// 受け付けられただけの絞り込み配信は送信済みに数えない
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &message.SchedulerResult{Sent: 1, Accepted: 1}, result)
+	assert.Equal(s.T(), model.MessageStatusSent, push.Status)
+	assert.Equal(s.T(), model.MessageStatusSending, narrowcast.Status)
+}
+
 func TestMessageInteractorTestSuite(t *testing.T) {
 	suite.Run(t, new(MessageInteractorTestSuite))
 }
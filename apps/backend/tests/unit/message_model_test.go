@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -55,11 +56,33 @@ func (s *MessageModelTestSuite) TestCanSend_FailedStatus() {
 	assert.True(s.T(), s.campaign.CanSend())
 }
 
+func (s *MessageModelTestSuite) TestCanEdit() {
+	// 送信済み以外は編集可能
+	for _, status := range []model.MessageStatus{model.MessageStatusDraft, model.MessageStatusScheduled, model.MessageStatusFailed} {
+		s.campaign.Status = status
+		assert.True(s.T(), s.campaign.CanEdit(), status)
+	}
+
+	s.campaign.Status = model.MessageStatusSent
+	assert.False(s.T(), s.campaign.CanEdit())
+}
+
+func (s *MessageModelTestSuite) TestEdit() {
+	// 編集でタイトル・本文が置き換わり、配信予定日時・ステータスは変わらない
+	s.campaign.Edit("新タイトル", "新本文")
+
+	assert.Equal(s.T(), "新タイトル", s.campaign.Title)
+	assert.Equal(s.T(), "新本文", s.campaign.Body)
+	assert.Nil(s.T(), s.campaign.ScheduledAt)
+	assert.Equal(s.T(), model.MessageStatusDraft, s.campaign.Status)
+	assert.True(s.T(), s.campaign.UpdatedAt.After(s.fixedTime))
+}
+
 func (s *MessageModelTestSuite) TestMarkAsSent() {
 	// メッセージを送信済みにマーク
 	beforeTime := time.Now()
-	s.campaign.Status = model.MessageStatusDraft
-	s.campaign.MarkAsSent()
+	s.campaign.Status = model.MessageStatusSending
+	assert.NoError(s.T(), s.campaign.MarkAsSent())
 
 	assert.Equal(s.T(), model.MessageStatusSent, s.campaign.Status)
 	assert.NotNil(s.T(), s.campaign.SentAt)
@@ -69,8 +92,8 @@ func (s *MessageModelTestSuite) TestMarkAsSent() {
 
 func (s *MessageModelTestSuite) TestMarkAsFailed() {
 	// メッセージを失敗としてマーク
-	s.campaign.Status = model.MessageStatusDraft
-	s.campaign.MarkAsFailed()
+	s.campaign.Status = model.MessageStatusSending
+	assert.NoError(s.T(), s.campaign.MarkAsFailed())
 
 	assert.Equal(s.T(), model.MessageStatusFailed, s.campaign.Status)
 }
@@ -79,12 +102,21 @@ func (s *MessageModelTestSuite) TestSchedule() {
 	// メッセージをスケジューリング
 	scheduleTime := s.fixedTime.Add(1 * time.Hour)
 	s.campaign.Status = model.MessageStatusDraft
-	s.campaign.Schedule(scheduleTime)
+	assert.NoError(s.T(), s.campaign.Schedule(scheduleTime))
 
 	assert.Equal(s.T(), model.MessageStatusScheduled, s.campaign.Status)
 	assert.Equal(s.T(), &scheduleTime, s.campaign.ScheduledAt)
 }
 
+func (s *MessageModelTestSuite) TestUnschedule() {
+	// 予約解除で下書きに戻り、予約日時がクリアされる
+	assert.NoError(s.T(), s.campaign.Schedule(s.fixedTime.Add(1*time.Hour)))
+	assert.NoError(s.T(), s.campaign.Unschedule())
+
+	assert.Equal(s.T(), model.MessageStatusDraft, s.campaign.Status)
+	assert.Nil(s.T(), s.campaign.ScheduledAt)
+}
+
 // エッジケースのテスト
 func (s *MessageModelTestSuite) TestCanSend_WithNilMessage() {
 	// nilチェックのテスト
@@ -98,23 +130,22 @@ func (s *MessageModelTestSuite) TestSchedule_PastTime() {
 	pastTime := s.fixedTime.Add(-1 * time.Hour)
 	s.campaign.Status = model.MessageStatusDraft
 
-	// 過去の時間での動作確認（実装によってはエラーになるべき）
-	s.campaign.Schedule(pastTime)
+	// 過去日時の検証はユースケース層（Clock依存）で行うため、モデルは受け付ける
+	assert.NoError(s.T(), s.campaign.Schedule(pastTime))
 
-	// 実装に応じてアサーションを調整
 	assert.Equal(s.T(), model.MessageStatusScheduled, s.campaign.Status)
 	assert.Equal(s.T(), &pastTime, s.campaign.ScheduledAt)
 }
 
 func (s *MessageModelTestSuite) TestMarkAsSent_Idempotent() {
 	// 冪等性のテスト（複数回実行しても結果が同じ）
-	s.campaign.Status = model.MessageStatusDraft
-	s.campaign.MarkAsSent()
+	s.campaign.Status = model.MessageStatusSending
+	assert.NoError(s.T(), s.campaign.MarkAsSent())
 
 	firstSentAt := s.campaign.SentAt
 
 	// 再度実行
-	s.campaign.MarkAsSent()
+	assert.NoError(s.T(), s.campaign.MarkAsSent())
 
 	assert.Equal(s.T(), model.MessageStatusSent, s.campaign.Status)
 	assert.Equal(s.T(), firstSentAt, s.campaign.SentAt) // 時刻は変わらないはず
@@ -125,37 +156,109 @@ func (s *MessageModelTestSuite) TestStatusTransitions() {
 	testCases := []struct {
 		name           string
 		initialStatus  model.MessageStatus
-		action         func(*model.Message)
+		action         func(*model.Message) error
 		expectedStatus model.MessageStatus
 		shouldSuccess  bool
 	}{
 		{
-			name:          "Draft to Sent",
+			name:          "Draft to Sending",
 			initialStatus: model.MessageStatusDraft,
-			action: func(c *model.Message) {
-				c.MarkAsSent()
+			action: func(c *model.Message) error {
+				return c.MarkAsSending()
+			},
+			expectedStatus: model.MessageStatusSending,
+			shouldSuccess:  true,
+		},
+		{
+			name:          "Sending to Sent",
+			initialStatus: model.MessageStatusSending,
+			action: func(c *model.Message) error {
+				return c.MarkAsSent()
 			},
 			expectedStatus: model.MessageStatusSent,
 			shouldSuccess:  true,
 		},
 		{
-			name:          "Draft to Failed",
-			initialStatus: model.MessageStatusDraft,
-			action: func(c *model.Message) {
-				c.MarkAsFailed()
+			name:          "Sending to Failed",
+			initialStatus: model.MessageStatusSending,
+			action: func(c *model.Message) error {
+				return c.MarkAsFailed()
 			},
 			expectedStatus: model.MessageStatusFailed,
 			shouldSuccess:  true,
 		},
 		{
-			name:          "Scheduled to Sent",
+			name:          "Failed to Sending (retry)",
+			initialStatus: model.MessageStatusFailed,
+			action: func(c *model.Message) error {
+				return c.MarkAsSending()
+			},
+			expectedStatus: model.MessageStatusSending,
+			shouldSuccess:  true,
+		},
+		{
+			name:          "Scheduled to Canceled",
 			initialStatus: model.MessageStatusScheduled,
-			action: func(c *model.Message) {
-				c.MarkAsSent()
+			action: func(c *model.Message) error {
+				return c.Cancel()
 			},
-			expectedStatus: model.MessageStatusSent,
+			expectedStatus: model.MessageStatusCanceled,
+			shouldSuccess:  true,
+		},
+		{
+			name:          "Sent to Archived",
+			initialStatus: model.MessageStatusSent,
+			action: func(c *model.Message) error {
+				return c.Archive()
+			},
+			expectedStatus: model.MessageStatusArchived,
 			shouldSuccess:  true,
 		},
+		{
+			name:          "Draft to Sent is rejected",
+			initialStatus: model.MessageStatusDraft,
+			action: func(c *model.Message) error {
+				return c.MarkAsSent()
+			},
+			expectedStatus: model.MessageStatusDraft,
+			shouldSuccess:  false,
+		},
+		{
+			name:          "Sent to Sending is rejected",
+			initialStatus: model.MessageStatusSent,
+			action: func(c *model.Message) error {
+				return c.MarkAsSending()
+			},
+			expectedStatus: model.MessageStatusSent,
+			shouldSuccess:  false,
+		},
+		{
+			name:          "Sent to Canceled is rejected",
+			initialStatus: model.MessageStatusSent,
+			action: func(c *model.Message) error {
+				return c.Cancel()
+			},
+			expectedStatus: model.MessageStatusSent,
+			shouldSuccess:  false,
+		},
+		{
+			name:          "Draft to Draft via Unschedule is rejected",
+			initialStatus: model.MessageStatusDraft,
+			action: func(c *model.Message) error {
+				return c.Unschedule()
+			},
+			expectedStatus: model.MessageStatusDraft,
+			shouldSuccess:  false,
+		},
+		{
+			name:          "Archived to Sending is rejected",
+			initialStatus: model.MessageStatusArchived,
+			action: func(c *model.Message) error {
+				return c.MarkAsSending()
+			},
+			expectedStatus: model.MessageStatusArchived,
+			shouldSuccess:  false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -170,13 +273,99 @@ func (s *MessageModelTestSuite) TestStatusTransitions() {
 				UpdatedAt: s.fixedTime,
 			}
 
-			tc.action(campaign)
+			err := tc.action(campaign)
+			if tc.shouldSuccess {
+				assert.NoError(s.T(), err)
+			} else {
+				assert.ErrorIs(s.T(), err, model.ErrInvalidTransition)
+			}
 			assert.Equal(s.T(), tc.expectedStatus, campaign.Status)
 		})
 	}
 }
 
+func (s *MessageModelTestSuite) TestTransitionError() {
+	// 不正な遷移は遷移元・遷移先を持つ型付きエラーを返す
+	s.campaign.Status = model.MessageStatusSent
+	err := s.campaign.Schedule(s.fixedTime.Add(1 * time.Hour))
+
+	var transitionErr *model.TransitionError
+	assert.ErrorAs(s.T(), err, &transitionErr)
+	assert.Equal(s.T(), model.MessageStatusSent, transitionErr.From)
+	assert.Equal(s.T(), model.MessageStatusScheduled, transitionErr.To)
+	assert.Nil(s.T(), s.campaign.ScheduledAt)
+}
+
+func (s *MessageModelTestSuite) TestMessageStatus_IsValid() {
+	// 遷移表に定義されたステータスのみ有効
+	assert.True(s.T(), model.MessageStatusCanceled.IsValid())
+	assert.False(s.T(), model.MessageStatus("deleted").IsValid())
+}
+
 // テストスイートを実行するためのエントリーポイント
+func (s *MessageModelTestSuite) TestScheduleIn_JSONHasUTCAndLocal() {
+	scheduledAt := time.Date(2024, 1, 5, 20, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	assert.NoError(s.T(), s.campaign.ScheduleIn(scheduledAt, "Asia/Tokyo"))
+
+	data, err := json.Marshal(s.campaign)
+	assert.NoError(s.T(), err)
+
+	var decoded map[string]interface{}
+	assert.NoError(s.T(), json.Unmarshal(data, &decoded))
+	assert.Equal(s.T(), "2024-01-05T11:00:00Z", decoded["scheduled_at"])
+	assert.Equal(s.T(), "2024-01-05T20:00:00+09:00", decoded["scheduled_at_local"])
+	assert.Equal(s.T(), "Asia/Tokyo", decoded["timezone"])
+
+	// 予約解除でタイムゾーンも消える
+	assert.NoError(s.T(), s.campaign.Unschedule())
+	assert.Empty(s.T(), s.campaign.Timezone)
+	assert.Nil(s.T(), s.campaign.ScheduledAtLocal())
+}
+
+func (s *MessageModelTestSuite) TestScheduleIn_InvalidTimezone() {
+	err := s.campaign.ScheduleIn(s.fixedTime.Add(time.Hour), "Asia/Nowhere")
+	assert.ErrorIs(s.T(), err, model.ErrInvalidTimezone)
+	assert.Equal(s.T(), model.MessageStatusDraft, s.campaign.Status)
+}
+
+func (s *MessageModelTestSuite) TestDelete_BlocksTransitionsUntilRestored() {
+	scheduledAt := s.fixedTime.Add(time.Hour)
+	assert.NoError(s.T(), s.campaign.Schedule(scheduledAt))
+
+	assert.NoError(s.T(), s.campaign.Delete(s.fixedTime))
+	assert.True(s.T(), s.campaign.IsDeleted())
+	assert.Equal(s.T(), s.fixedTime, *s.campaign.DeletedAt)
+	assert.False(s.T(), s.campaign.CanSend())
+	assert.False(s.T(), s.campaign.CanEdit())
+	assert.ErrorIs(s.T(), s.campaign.MarkAsSending(), model.ErrMessageDeleted)
+	assert.ErrorIs(s.T(), s.campaign.Delete(s.fixedTime), model.ErrMessageDeleted)
+
+	// 予約日時前に復元した場合は予約を維持する
+	assert.NoError(s.T(), s.campaign.Restore(s.fixedTime.Add(time.Minute)))
+	assert.False(s.T(), s.campaign.IsDeleted())
+	assert.Equal(s.T(), model.MessageStatusScheduled, s.campaign.Status)
+	assert.Equal(s.T(), scheduledAt, *s.campaign.ScheduledAt)
+	assert.ErrorIs(s.T(), s.campaign.Restore(s.fixedTime), model.ErrMessageNotDeleted)
+}
+
+func (s *MessageModelTestSuite) TestRestore_AfterScheduledTimeReturnsToDraft() {
+	assert.NoError(s.T(), s.campaign.ScheduleIn(s.fixedTime.Add(time.Hour), "Asia/Tokyo"))
+	assert.NoError(s.T(), s.campaign.Delete(s.fixedTime))
+
+	assert.NoError(s.T(), s.campaign.Restore(s.fixedTime.Add(2*time.Hour)))
+
+	assert.Equal(s.T(), model.MessageStatusDraft, s.campaign.Status)
+	assert.Nil(s.T(), s.campaign.ScheduledAt)
+	assert.Empty(s.T(), s.campaign.Timezone)
+}
+
+func (s *MessageModelTestSuite) TestDelete_WhileSending() {
+	assert.NoError(s.T(), s.campaign.MarkAsSending())
+
+	assert.ErrorIs(s.T(), s.campaign.Delete(s.fixedTime), model.ErrMessageSending)
+	assert.False(s.T(), s.campaign.IsDeleted())
+}
+
 func TestMessageModelTestSuite(t *testing.T) {
 	suite.Run(t, new(MessageModelTestSuite))
 }
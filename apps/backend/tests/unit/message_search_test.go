@@ -0,0 +1,55 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type MessageSearchTestSuite struct {
+	suite.Suite
+}
+
+func (s *MessageSearchTestSuite) TestSearchTerms() {
+	// 全角スペースでも区切り、大文字小文字違いの重複は除く
+	assert.Equal(s.T(), []string{"夏", "ライブ", "LIVE"}, model.SearchTerms(" 夏　ライブ LIVE live "))
+	assert.Empty(s.T(), model.SearchTerms("　 "))
+}
+
+func (s *MessageSearchTestSuite) TestHighlight_TitleAndBody() {
+	result := &model.MessageSearchResult{Message: model.NewMessage("夏のLIVE告知", "今年の夏もliveやります <必見>")}
+
+	result.Highlight([]string{"live", "夏"})
+
+	assert.Equal(s.T(), "<mark>夏</mark>の<mark>LIVE</mark>告知", result.TitleHighlight)
+	assert.Equal(s.T(), "今年の<mark>夏</mark>も<mark>live</mark>やります &lt;必見&gt;", result.Snippet)
+}
+
+func (s *MessageSearchTestSuite) TestHighlight_SnippetAroundFirstMatch() {
+	body := strings.Repeat("あ", 200) + "アンコール" + strings.Repeat("い", 200)
+	result := &model.MessageSearchResult{Message: model.NewMessage("告知", body)}
+
+	result.Highlight([]string{"アンコール"})
+
+	assert.True(s.T(), strings.HasPrefix(result.Snippet, "…"))
+	assert.True(s.T(), strings.HasSuffix(result.Snippet, "…"))
+	assert.Contains(s.T(), result.Snippet, "<mark>アンコール</mark>")
+	plain := strings.NewReplacer("<mark>", "", "</mark>", "", "…", "").Replace(result.Snippet)
+	assert.Equal(s.T(), model.SearchSnippetLength, len([]rune(plain)))
+}
+
+func (s *MessageSearchTestSuite) TestHighlight_PrefersLongestTerm() {
+	result := &model.MessageSearchResult{Message: model.NewMessage("ライブ配信", "")}
+
+	result.Highlight([]string{"ライブ", "ライブ配信"})
+
+	assert.Equal(s.T(), "<mark>ライブ配信</mark>", result.TitleHighlight)
+}
+
+func TestMessageSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageSearchTestSuite))
+}
@@ -0,0 +1,70 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type MessageTemplateTestSuite struct {
+	suite.Suite
+}
+
+func (s *MessageTemplateTestSuite) TestVariables() {
+	template := model.NewMessageTemplate("告知", "{{title}}のお知らせ", "{{name}}さん、{{ title }}は{{date}}です")
+	assert.Equal(s.T(), []string{"title", "name", "date"}, template.Variables())
+}
+
+func (s *MessageTemplateTestSuite) TestRender_Success() {
+	template := model.NewMessageTemplate("告知", "{{title}}のお知らせ", "{{name}}さん、{{ title }}は{{date}}です")
+
+	title, body, err := template.Render(map[string]string{
+		"title": "配信",
+		"name":  "山田",
+		"date":  "明日",
+		"extra": "未使用の変数は無視",
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "配信のお知らせ", title)
+	assert.Equal(s.T(), "山田さん、配信は明日です", body)
+}
+
+func (s *MessageTemplateTestSuite) TestRender_EmptyValueIsAllowed() {
+	// 空文字は「指定あり」として扱う
+	template := model.NewMessageTemplate("告知", "お知らせ{{suffix}}", "本文")
+
+	title, _, err := template.Render(map[string]string{"suffix": ""})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "お知らせ", title)
+}
+
+func (s *MessageTemplateTestSuite) TestRender_MissingVariables() {
+	template := model.NewMessageTemplate("告知", "{{title}}のお知らせ", "{{name}}さん、{{date}}です")
+
+	_, _, err := template.Render(map[string]string{"title": "配信"})
+
+	assert.ErrorIs(s.T(), err, model.ErrMissingTemplateVariable)
+	var missingErr *model.MissingVariablesError
+	assert.ErrorAs(s.T(), err, &missingErr)
+	assert.Equal(s.T(), []string{"name", "date"}, missingErr.Names)
+}
+
+func (s *MessageTemplateTestSuite) TestNewMessageFromTemplate() {
+	template := model.NewMessageTemplate("告知", "{{title}}のお知らせ", "本文")
+
+	message, err := model.NewMessageFromTemplate(template, map[string]string{"title": "配信"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "配信のお知らせ", message.Title)
+	assert.Equal(s.T(), model.MessageStatusDraft, message.Status)
+	assert.Equal(s.T(), template.ID, *message.TemplateID)
+}
+
+func TestMessageTemplateTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageTemplateTestSuite))
+}
@@ -0,0 +1,111 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type MessageValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *MessageValidationTestSuite) TestUTF16Length() {
+	assert.Equal(s.T(), 5, model.UTF16Length("hello"))
+	assert.Equal(s.T(), 5, model.UTF16Length("こんにちは"))
+	// サロゲートペアになる絵文字は2文字として数える
+	assert.Equal(s.T(), 2, model.UTF16Length("😀"))
+	assert.Equal(s.T(), 4, model.UTF16Length("👍🏻"))
+}
+
+func (s *MessageValidationTestSuite) TestText_LimitCountsUTF16() {
+	content := model.MessageContent{Type: model.ContentTypeText, Text: &model.TextContent{Text: strings.Repeat("あ", model.TextMaxLength)}}
+	assert.NoError(s.T(), content.Validate())
+
+	// 文字数（rune）は5000未満でも、UTF-16では上限を超える
+	content.Text.Text = strings.Repeat("😀", model.TextMaxLength/2) + "a"
+	err := content.Validate()
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+	assert.Equal(s.T(), "text.text", model.FieldErrors(err)[0].Field)
+}
+
+func (s *MessageValidationTestSuite) TestText_Emojis() {
+	// index は UTF-16 単位で "$" を指す（"😀" は2コードユニット）
+	text := &model.TextContent{
+		Text:   "😀$と$",
+		Emojis: []model.TextEmoji{{Index: 2, ProductID: "p", EmojiID: "001"}, {Index: 4, ProductID: "p", EmojiID: "002"}},
+	}
+	assert.NoError(s.T(), text.Validate())
+
+	text.Emojis[1].Index = 3
+	text.Emojis[0].EmojiID = ""
+	fieldErrs := model.FieldErrors(text.Validate())
+	assert.Len(s.T(), fieldErrs, 2)
+	assert.Equal(s.T(), "emojis[0]", fieldErrs[0].Field)
+	assert.Equal(s.T(), "emojis[1].index", fieldErrs[1].Field)
+}
+
+func (s *MessageValidationTestSuite) TestText_TooManyEmojis() {
+	text := &model.TextContent{Text: strings.Repeat("$", model.TextMaxEmojis+1)}
+	for i := 0; i <= model.TextMaxEmojis; i++ {
+		text.Emojis = append(text.Emojis, model.TextEmoji{Index: i, ProductID: "p", EmojiID: "001"})
+	}
+	assert.ErrorIs(s.T(), text.Validate(), model.ErrInvalidContent)
+}
+
+func (s *MessageValidationTestSuite) TestMessage_BodyIncludesTitle() {
+	// コンテンツがない場合は「タイトル + 空行 + 本文」が1つのテキストになる
+	message := model.NewMessage("告知", strings.Repeat("a", model.TextMaxLength-4))
+	assert.NoError(s.T(), message.Validate())
+
+	message.Body += "a"
+	fieldErrs := model.FieldErrors(message.Validate())
+	assert.Len(s.T(), fieldErrs, 1)
+	assert.Equal(s.T(), "body", fieldErrs[0].Field)
+}
+
+func (s *MessageValidationTestSuite) TestMessage_CollectsAllFieldErrors() {
+	message := model.NewMessage("告知", "")
+	message.Parts = model.MessageParts{
+		{Type: model.ContentTypeImage, Image: &model.ImageContent{OriginalContentURL: "http://example.com/a.png", PreviewImageURL: "https://example.com/" + strings.Repeat("a", model.MediaURLMaxLength)}},
+		{Type: model.ContentTypeFlex, Flex: &model.FlexContent{AltText: strings.Repeat("a", model.FlexAltTextMaxLength+1), Contents: []byte(`{"type":"bubble"}`)}},
+	}
+	message.QuickReply = model.QuickReplyItems{{Action: model.Action{Type: model.ActionTypeMessage, Text: "a"}}}
+
+	err := message.Validate()
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+	var fields []string
+	for _, fieldErr := range model.FieldErrors(err) {
+		fields = append(fields, fieldErr.Field)
+	}
+	assert.Equal(s.T(), []string{
+		"parts[0].image.original_content_url",
+		"parts[0].image.preview_image_url",
+		"parts[1].flex.alt_text",
+		"quick_reply[0].action.label",
+	}, fields)
+}
+
+func (s *MessageValidationTestSuite) TestTemplate_FieldPath() {
+	content := model.MessageContent{Type: model.ContentTypeTemplate, Template: &model.TemplateContent{
+		Type:    model.TemplateTypeConfirm,
+		AltText: "確認",
+		Text:    "参加しますか？",
+		Actions: []model.Action{{Type: model.ActionTypeMessage, Label: "はい", Text: "はい"}, {Type: model.ActionTypeMessage, Label: "いいえ"}},
+	}}
+
+	fieldErrs := model.FieldErrors(content.Validate())
+
+	assert.Len(s.T(), fieldErrs, 1)
+	assert.Equal(s.T(), "template.actions[1].text", fieldErrs[0].Field)
+	assert.Equal(s.T(), "is required", fieldErrs[0].Message)
+}
+
+func TestMessageValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageValidationTestSuite))
+}
@@ -0,0 +1,106 @@
+package unit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type MulticastTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (s *MulticastTestSuite) SetupTest() {
+	s.now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+// lineUserIDs テスト用のLINEユーザーIDを n 件作成
+func lineUserIDs(n int) model.Recipients {
+	ids := make(model.Recipients, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("U%032x", i+1)
+	}
+	return ids
+}
+
+func (s *MulticastTestSuite) TestNewDeliveryBatches_ChunksBy500() {
+	messageID := uuid.New()
+
+	batches := model.NewDeliveryBatches(messageID, lineUserIDs(1201), s.now)
+
+	assert.Len(s.T(), batches, 3)
+	for i, batch := range batches {
+		assert.Equal(s.T(), i, batch.Index)
+		assert.Equal(s.T(), messageID, batch.MessageID)
+		assert.Equal(s.T(), model.DeliveryBatchStatusPending, batch.Status)
+	}
+	assert.Len(s.T(), batches[0].Recipients, model.MulticastMaxRecipients)
+	assert.Len(s.T(), batches[1].Recipients, model.MulticastMaxRecipients)
+	assert.Len(s.T(), batches[2].Recipients, 201)
+	assert.Equal(s.T(), lineUserIDs(501)[500], batches[1].Recipients[0])
+}
+
+func (s *MulticastTestSuite) TestSetRecipients_DedupsAndValidates() {
+	msg := model.NewMessage("告知", "本文")
+	ids := lineUserIDs(2)
+
+	assert.NoError(s.T(), msg.SetRecipients(model.Recipients{ids[0], ids[1], ids[0]}))
+	assert.Equal(s.T(), ids, msg.Recipients)
+
+	err := msg.SetRecipients(model.Recipients{ids[0], "user-1"})
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+	fieldErrs := model.FieldErrors(err)
+	assert.Len(s.T(), fieldErrs, 1)
+	assert.Equal(s.T(), "recipients[1]", fieldErrs[0].Field)
+	assert.Equal(s.T(), ids, msg.Recipients)
+}
+
+func (s *MulticastTestSuite) TestDeliveryBatch_RecordsResult() {
+	batch := model.NewDeliveryBatches(uuid.New(), lineUserIDs(1), s.now)[0]
+
+	batch.MarkAsFailed("LINE API error: status 500", s.now)
+	assert.False(s.T(), batch.IsSucceeded())
+	assert.Equal(s.T(), 1, batch.Attempts)
+
+	batch.MarkAsSucceeded("req-1", s.now.Add(time.Minute))
+	assert.True(s.T(), batch.IsSucceeded())
+	assert.Equal(s.T(), "req-1", batch.RequestID)
+	assert.Empty(s.T(), batch.Error)
+	assert.Equal(s.T(), 2, batch.Attempts)
+	assert.Equal(s.T(), s.now.Add(time.Minute), *batch.SentAt)
+}
+
+func (s *MulticastTestSuite) TestBroadcastTarget_RejectsRecipients() {
+	msg := model.NewMessage("告知", "本文")
+	assert.Equal(s.T(), model.DeliveryTargetRecipients, msg.Target)
+
+	assert.NoError(s.T(), msg.SetTarget(model.DeliveryTargetBroadcast))
+	assert.NoError(s.T(), msg.SetRecipients(lineUserIDs(1)))
+
+	fieldErrs := model.FieldErrors(msg.Validate())
+	assert.Len(s.T(), fieldErrs, 1)
+	assert.Equal(s.T(), "recipients", fieldErrs[0].Field)
+	assert.ErrorIs(s.T(), msg.SetTarget("everyone"), model.ErrInvalidContent)
+}
+
+func (s *MulticastTestSuite) TestMessageQuota_Reserve() {
+	quota := &model.MessageQuota{Limited: true, Limit: 1000, Used: 900}
+	assert.Equal(s.T(), 100, quota.Remaining())
+	assert.NoError(s.T(), quota.Reserve(100))
+	assert.ErrorIs(s.T(), quota.Reserve(101), model.ErrQuotaExceeded)
+
+	unlimited := &model.MessageQuota{Used: 5000}
+	assert.Equal(s.T(), -1, unlimited.Remaining())
+	assert.NoError(s.T(), unlimited.Reserve(100000))
+}
+
+func TestMulticastTestSuite(t *testing.T) {
+	suite.Run(t, new(MulticastTestSuite))
+}
@@ -0,0 +1,115 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/infrastructure/external"
+)
+
+type NarrowcastTestSuite struct {
+	suite.Suite
+	message *model.Message
+}
+
+func (s *NarrowcastTestSuite) SetupTest() {
+	s.message = model.NewMessage("絞り込み配信", "本文")
+	s.Require().NoError(s.message.SetTarget(model.DeliveryTargetNarrowcast))
+}
+
+func (s *NarrowcastTestSuite) TestSetNarrowcast_ValidatesFilter() {
+	err := s.message.SetNarrowcast(&model.NarrowcastFilter{
+		AudienceGroupIDs: []int64{0},
+		Genders:          []string{"other"},
+		Ages:             &model.NarrowcastAgeRange{Gte: "age_30", Lt: "age_20"},
+		Areas:            []string{"tokyo"},
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+	fields := map[string]bool{}
+	for _, fe := range model.FieldErrors(err) {
+		fields[fe.Field] = true
+	}
+	assert.True(s.T(), fields["narrowcast.audience_group_ids[0]"])
+	assert.True(s.T(), fields["narrowcast.genders[0]"])
+	assert.True(s.T(), fields["narrowcast.ages.lt"])
+	assert.True(s.T(), fields["narrowcast.areas[0]"])
+	assert.Nil(s.T(), s.message.Narrowcast)
+}
+
+func (s *NarrowcastTestSuite) TestSetNarrowcast_RequiresCondition() {
+	err := s.message.SetNarrowcast(&model.NarrowcastFilter{Max: 100})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+}
+
+func (s *NarrowcastTestSuite) TestValidate_NarrowcastRequiresFilter() {
+	assert.ErrorIs(s.T(), s.message.Validate(), model.ErrInvalidContent)
+
+	s.Require().NoError(s.message.SetNarrowcast(&model.NarrowcastFilter{Genders: []string{"female"}}))
+	assert.NoError(s.T(), s.message.Validate())
+
+	// 別の送信先に切り替えると条件は解除される
+	s.Require().NoError(s.message.SetTarget(model.DeliveryTargetBroadcast))
+	assert.Nil(s.T(), s.message.Narrowcast)
+}
+
+func (s *NarrowcastTestSuite) TestBuildNarrowcastMessage() {
+	s.Require().NoError(s.message.SetNarrowcast(&model.NarrowcastFilter{
+		AudienceGroupIDs: []int64{101, 102},
+		Genders:          []string{"female"},
+		Ages:             &model.NarrowcastAgeRange{Gte: "age_20", Lt: "age_35"},
+		Areas:            []string{"jp_13", "jp_14"},
+		Max:              1000,
+	}))
+
+	request, err := external.BuildNarrowcastMessage(s.message)
+	s.Require().NoError(err)
+	data, err := json.Marshal(request)
+	s.Require().NoError(err)
+
+	assert.JSONEq(s.T(), `{
+		"messages": [{"type": "text", "text": "絞り込み配信\n\n本文"}],
+		"recipient": {"type": "operator", "or": [
+			{"type": "audience", "audienceGroupId": 101},
+			{"type": "audience", "audienceGroupId": 102}
+		]},
+		"filter": {"demographic": {"type": "operator", "and": [
+			{"type": "gender", "oneOf": ["female"]},
+			{"type": "age", "gte": "age_20", "lt": "age_35"},
+			{"type": "area", "oneOf": ["jp_13", "jp_14"]}
+		]}},
+		"limit": {"max": 1000}
+	}`, string(data))
+}
+
+func (s *NarrowcastTestSuite) TestBuildNarrowcastMessage_SingleCondition() {
+	s.Require().NoError(s.message.SetNarrowcast(&model.NarrowcastFilter{AudienceGroupIDs: []int64{101}}))
+
+	request, err := external.BuildNarrowcastMessage(s.message)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), &external.LineRecipient{Type: "audience", AudienceGroupID: 101}, request.Recipient)
+	assert.Nil(s.T(), request.Filter)
+	assert.Nil(s.T(), request.Limit)
+}
+
+func (s *NarrowcastTestSuite) TestCompleteNarrowcast() {
+	s.Require().NoError(s.message.MarkAsSending())
+
+	err := s.message.CompleteNarrowcast(&model.NarrowcastProgress{
+		Phase: model.NarrowcastPhaseFailed, TargetCount: 10, FailureCount: 10,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.MessageStatusFailed, s.message.Status)
+	assert.Equal(s.T(), &model.DeliveryCounts{Target: 10, Failure: 10}, s.message.DeliveryCounts)
+}
+
+func TestNarrowcastTestSuite(t *testing.T) {
+	suite.Run(t, new(NarrowcastTestSuite))
+}
@@ -0,0 +1,67 @@
+package unit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type QuickReplyTestSuite struct {
+	suite.Suite
+}
+
+func (s *QuickReplyTestSuite) TestValidate_AllActionTypes() {
+	items := model.QuickReplyItems{
+		{Action: model.Action{Type: model.ActionTypeMessage, Label: "見たよ", Text: "見たよ！"}},
+		{Action: model.Action{Type: model.ActionTypePostback, Label: "通知ON", Data: "notify=on"}},
+		{Action: model.Action{Type: model.ActionTypeDatetimePicker, Label: "日程", Data: "pick", Mode: "date"}},
+		{Action: model.Action{Type: model.ActionTypeCamera, Label: "カメラ"}},
+		{Action: model.Action{Type: model.ActionTypeCameraRoll, Label: "写真"}},
+		{ImageURL: "https://example.com/pin.png", Action: model.Action{Type: model.ActionTypeLocation, Label: "位置情報"}},
+	}
+	assert.NoError(s.T(), items.Validate())
+}
+
+func (s *QuickReplyTestSuite) TestValidate_TooManyItems() {
+	// 最大13項目
+	items := make(model.QuickReplyItems, model.QuickReplyMaxItems+1)
+	for i := range items {
+		items[i] = model.QuickReplyItem{Action: model.Action{Type: model.ActionTypeMessage, Label: fmt.Sprintf("%d", i), Text: "a"}}
+	}
+	assert.ErrorIs(s.T(), items.Validate(), model.ErrInvalidContent)
+	assert.NoError(s.T(), items[:model.QuickReplyMaxItems].Validate())
+}
+
+func (s *QuickReplyTestSuite) TestValidate_URIActionNotAllowed() {
+	// URIアクションはクイックリプライでは使えない
+	items := model.QuickReplyItems{
+		{Action: model.Action{Type: model.ActionTypeURI, Label: "開く", URI: "https://example.com"}},
+	}
+	assert.ErrorIs(s.T(), items.Validate(), model.ErrInvalidContent)
+}
+
+func (s *QuickReplyTestSuite) TestValidate_HTTPIcon() {
+	items := model.QuickReplyItems{
+		{ImageURL: "http://example.com/icon.png", Action: model.Action{Type: model.ActionTypeCamera, Label: "カメラ"}},
+	}
+	assert.ErrorIs(s.T(), items.Validate(), model.ErrInvalidContent)
+}
+
+func (s *QuickReplyTestSuite) TestTemplateRejectsQuickReplyOnlyAction() {
+	// カメラ等のアクションはテンプレートには設定できない
+	template := &model.TemplateContent{
+		Type:    model.TemplateTypeButtons,
+		AltText: "写真募集",
+		Text:    "ファンアートを送ってね",
+		Actions: []model.Action{{Type: model.ActionTypeCamera, Label: "カメラ"}},
+	}
+	assert.ErrorIs(s.T(), template.Validate(), model.ErrInvalidContent)
+}
+
+func TestQuickReplyTestSuite(t *testing.T) {
+	suite.Run(t, new(QuickReplyTestSuite))
+}
@@ -0,0 +1,210 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type RecurrenceTestSuite struct {
+	suite.Suite
+	tokyo *time.Location
+}
+
+func (s *RecurrenceTestSuite) SetupTest() {
+	var err error
+	s.tokyo, err = time.LoadLocation("Asia/Tokyo")
+	require.NoError(s.T(), err)
+}
+
+func (s *RecurrenceTestSuite) next(expression string, loc *time.Location, start, after time.Time, n int) []time.Time {
+	r, err := model.ParseRecurrence(expression, loc, start)
+	require.NoError(s.T(), err)
+	return model.Occurrences(r, after, n)
+}
+
+func (s *RecurrenceTestSuite) TestCron_WeeklyInTimezone() {
+	// 毎週金曜20時（JST）
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, s.tokyo) // 月曜
+	times := s.next("0 20 * * FRI", s.tokyo, after, after, 3)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 1, 5, 20, 0, 0, 0, s.tokyo),
+		time.Date(2024, 1, 12, 20, 0, 0, 0, s.tokyo),
+		time.Date(2024, 1, 19, 20, 0, 0, 0, s.tokyo),
+	}, times)
+	assert.Equal(s.T(), 11, times[0].UTC().Hour())
+}
+
+func (s *RecurrenceTestSuite) TestCron_ListsRangesAndSteps() {
+	after := time.Date(2024, 1, 1, 9, 50, 0, 0, time.UTC)
+	times := s.next("*/30 9-10 * * 1-5", time.UTC, after, after, 4)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC),
+	}, times)
+}
+
+func (s *RecurrenceTestSuite) TestCron_DayOfMonthOrWeekday() {
+	// 日と曜日の両方を指定した場合はどちらかに一致すれば発生する
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := s.next("0 12 15 * SUN", time.UTC, after, after, 3)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 14, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC),
+	}, times)
+}
+
+func (s *RecurrenceTestSuite) TestCron_DSTTransitions() {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(s.T(), err)
+
+	// 毎日 02:30 / 01:30 のローカル時刻で発生し、夏時間の切り替え日も1回だけ発生する
+	springForward := time.Date(2024, 3, 9, 0, 0, 0, 0, newYork)
+	times := s.next("30 2 * * *", newYork, springForward, springForward, 3)
+	assert.Equal(s.T(), time.Date(2024, 3, 9, 7, 30, 0, 0, time.UTC), times[0].UTC())
+	// 02:30 が存在しない日は切り替え幅だけ後ろ（03:30 EDT）
+	assert.Equal(s.T(), time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC), times[1].UTC())
+	assert.Equal(s.T(), time.Date(2024, 3, 11, 6, 30, 0, 0, time.UTC), times[2].UTC())
+
+	// 01:30 が2回ある日は早い方（EDT）のみ
+	fallBack := time.Date(2024, 11, 2, 12, 0, 0, 0, newYork)
+	times = s.next("30 1 * * *", newYork, fallBack, fallBack, 2)
+	assert.Equal(s.T(), time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC), times[0].UTC())
+	assert.Equal(s.T(), time.Date(2024, 11, 4, 6, 30, 0, 0, time.UTC), times[1].UTC())
+}
+
+func (s *RecurrenceTestSuite) TestRRule_WeeklyKeepsLocalTimeAcrossDST() {
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(s.T(), err)
+
+	// 毎週土曜 20:00（ロンドン）。UTCでは夏時間の開始前後で1時間ずれる
+	start := time.Date(2024, 3, 27, 0, 0, 0, 0, london)
+	times := s.next("FREQ=WEEKLY;BYDAY=SA;BYHOUR=20;BYMINUTE=0", london, start, start, 2)
+	assert.Equal(s.T(), time.Date(2024, 3, 30, 20, 0, 0, 0, time.UTC), times[0].UTC())
+	assert.Equal(s.T(), time.Date(2024, 4, 6, 19, 0, 0, 0, time.UTC), times[1].UTC())
+}
+
+func (s *RecurrenceTestSuite) TestParseLocalTime() {
+	t, err := model.ParseLocalTime("2024-01-05T20:00", s.tokyo)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), time.Date(2024, 1, 5, 11, 0, 0, 0, time.UTC), t.UTC())
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(s.T(), err)
+	_, err = model.ParseLocalTime("2024-03-10T02:30", newYork)
+	assert.ErrorIs(s.T(), err, model.ErrInvalidLocalTime)
+
+	_, err = model.LoadTimezone("Local")
+	assert.ErrorIs(s.T(), err, model.ErrInvalidTimezone)
+}
+
+func (s *RecurrenceTestSuite) TestCron_Invalid() {
+	for _, expression := range []string{"* * * *", "60 * * * *", "0 25 * * *", "0 0 * * XYZ", "5-1 * * * *", "*/0 * * * *"} {
+		_, err := model.ParseRecurrence(expression, time.UTC, time.Now())
+		assert.ErrorIs(s.T(), err, model.ErrInvalidRecurrence, expression)
+	}
+}
+
+func (s *RecurrenceTestSuite) TestRRule_WeeklyWithInterval() {
+	// 隔週の火・木 21:30
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, s.tokyo)
+	times := s.next("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;BYHOUR=21;BYMINUTE=30", s.tokyo, start, start, 4)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 1, 2, 21, 30, 0, 0, s.tokyo),
+		time.Date(2024, 1, 4, 21, 30, 0, 0, s.tokyo),
+		time.Date(2024, 1, 16, 21, 30, 0, 0, s.tokyo),
+		time.Date(2024, 1, 18, 21, 30, 0, 0, s.tokyo),
+	}, times)
+}
+
+func (s *RecurrenceTestSuite) TestRRule_MonthlyLastFriday() {
+	start := time.Date(2024, 1, 1, 19, 0, 0, 0, s.tokyo)
+	times := s.next("FREQ=MONTHLY;BYDAY=-1FR", s.tokyo, start, start, 3)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 1, 26, 19, 0, 0, 0, s.tokyo),
+		time.Date(2024, 2, 23, 19, 0, 0, 0, s.tokyo),
+		time.Date(2024, 3, 29, 19, 0, 0, 0, s.tokyo),
+	}, times)
+}
+
+func (s *RecurrenceTestSuite) TestRRule_CountAndUntil() {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	// COUNT は起点から数える
+	times := s.next("FREQ=DAILY;COUNT=3", time.UTC, start, start.Add(time.Hour), 10)
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 8, 0, 0, 0, time.UTC),
+	}, times)
+
+	times = s.next("FREQ=DAILY;UNTIL=20240103", time.UTC, start, start.Add(-time.Minute), 10)
+	assert.Len(s.T(), times, 3)
+}
+
+func (s *RecurrenceTestSuite) TestRRule_Invalid() {
+	for _, expression := range []string{"FREQ=HOURLY", "INTERVAL=2", "FREQ=DAILY;INTERVAL=0", "FREQ=WEEKLY;BYDAY=XX", "FREQ=DAILY;COUNT=2;UNTIL=20240101", "FREQ=DAILY;BYSETPOS=1"} {
+		_, err := model.ParseRecurrence(expression, time.UTC, time.Now())
+		assert.ErrorIs(s.T(), err, model.ErrInvalidRecurrence, expression)
+	}
+}
+
+func (s *RecurrenceTestSuite) TestNewRecurringSchedule() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule, err := model.NewRecurringSchedule(uuid.New(), "0 20 * * FRI", "Asia/Tokyo", time.Time{}, now)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), model.RecurringScheduleStatusActive, schedule.Status)
+	assert.Equal(s.T(), time.Date(2024, 1, 5, 11, 0, 0, 0, time.UTC), *schedule.NextRunAt)
+
+	_, err = model.NewRecurringSchedule(uuid.New(), "0 20 * * FRI", "Mars/Olympus", time.Time{}, now)
+	assert.ErrorIs(s.T(), err, model.ErrInvalidRecurrence)
+}
+
+func (s *RecurrenceTestSuite) TestRecurringSchedule_AdvanceSkipsMissedOccurrences() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule, err := model.NewRecurringSchedule(uuid.New(), "0 9 * * *", "UTC", time.Time{}, now)
+	require.NoError(s.T(), err)
+
+	// 3日分遅れて実行されても次回は翌日分のみ
+	late := time.Date(2024, 1, 4, 10, 0, 0, 0, time.UTC)
+	assert.True(s.T(), schedule.IsDue(late))
+	require.NoError(s.T(), schedule.Advance(late))
+
+	assert.Equal(s.T(), time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), *schedule.LastRunAt)
+	assert.Equal(s.T(), time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC), *schedule.NextRunAt)
+}
+
+func (s *RecurrenceTestSuite) TestRecurringSchedule_PauseAndResume() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule, err := model.NewRecurringSchedule(uuid.New(), "0 9 * * *", "UTC", time.Time{}, now)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), schedule.Pause())
+	assert.False(s.T(), schedule.IsDue(now.AddDate(0, 0, 2)))
+	assert.ErrorIs(s.T(), schedule.Pause(), model.ErrAlreadyPaused)
+
+	// 停止中に過ぎた回は送らない
+	resumedAt := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	require.NoError(s.T(), schedule.Resume(resumedAt))
+	assert.Equal(s.T(), model.RecurringScheduleStatusActive, schedule.Status)
+	assert.Equal(s.T(), time.Date(2024, 1, 11, 9, 0, 0, 0, time.UTC), *schedule.NextRunAt)
+	assert.ErrorIs(s.T(), schedule.Resume(resumedAt), model.ErrNotPaused)
+}
+
+func TestRecurrenceTestSuite(t *testing.T) {
+	suite.Run(t, new(RecurrenceTestSuite))
+}
@@ -0,0 +1,301 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/application/richmenu"
+	"vt-link/backend/internal/domain/model"
+	repoMocks "vt-link/backend/internal/domain/repository/mocks"
+	serviceMocks "vt-link/backend/internal/domain/service/mocks"
+	"vt-link/backend/internal/infrastructure/external"
+	"vt-link/backend/internal/shared/errx"
+)
+
+type RichMenuTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	clock            *fixedClock
+	mockRichMenuRepo *repoMocks.MockRichMenuRepository
+	mockClient       *serviceMocks.MockRichMenuClient
+	interactor       richmenu.Usecase
+}
+
+func (s *RichMenuTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.clock = &fixedClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	s.mockRichMenuRepo = repoMocks.NewMockRichMenuRepository(s.T())
+	s.mockClient = serviceMocks.NewMockRichMenuClient(s.T())
+	s.interactor = richmenu.NewInteractor(s.mockRichMenuRepo, s.mockClient, s.clock)
+}
+
+// definition 左半分がURL、右半分がメッセージ送信のリッチメニュー
+func (s *RichMenuTestSuite) definition() model.RichMenuDefinition {
+	return model.RichMenuDefinition{
+		Name:        "配信メニュー",
+		ChatBarText: "メニュー",
+		Size:        model.RichMenuSize{Width: 2500, Height: 843},
+		Areas: model.RichMenuAreas{
+			{
+				Bounds: model.RichMenuBounds{X: 0, Y: 0, Width: 1250, Height: 843},
+				Action: model.Action{Type: model.ActionTypeURI, Label: "チャンネル", URI: "https://example.com/channel"},
+			},
+			{
+				Bounds: model.RichMenuBounds{X: 1250, Y: 0, Width: 1250, Height: 843},
+				Action: model.Action{Type: model.ActionTypeMessage, Text: "配信予定"},
+			},
+		},
+	}
+}
+
+// appliedMenu LINEに登録済みのリッチメニュー
+func (s *RichMenuTestSuite) appliedMenu(lineRichMenuID string, withImage bool) *model.RichMenu {
+	menu, err := model.NewRichMenu(s.definition())
+	s.Require().NoError(err)
+	menu.MarkApplied(lineRichMenuID, s.clock.now.Add(-time.Hour))
+	if withImage {
+		s.Require().NoError(menu.SetImage("image/png", []byte("old-png")))
+	}
+	return menu
+}
+
+func (s *RichMenuTestSuite) fields(err error) []string {
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	fields := make([]string, len(appErr.Fields))
+	for i, field := range appErr.Fields {
+		fields[i] = field.Field
+	}
+	return fields
+}
+
+func (s *RichMenuTestSuite) TestDefinition_ReportsAllFieldErrors() {
+	definition := s.definition()
+	definition.ChatBarText = "メニューはこちらをタップしてください"
+	definition.Areas[0].Bounds.Width = 1300 // 右側の領域と重なってもよいが、メニューからはみ出してはいけない
+	definition.Areas[1].Bounds.X = 1300
+	definition.Areas[1].Action = model.Action{Type: model.ActionTypeCamera, Label: "カメラ"}
+
+	err := definition.Validate()
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidContent)
+	var fields []string
+	for _, fieldErr := range model.FieldErrors(err) {
+		fields = append(fields, fieldErr.Field)
+	}
+	assert.ElementsMatch(s.T(), []string{"chat_bar_text", "areas[1].bounds", "areas[1].action.type"}, fields)
+}
+
+func (s *RichMenuTestSuite) TestDefinition_RejectsNarrowSize() {
+	definition := s.definition()
+	definition.Size = model.RichMenuSize{Width: 1200, Height: 1000}
+
+	err := definition.Validate()
+
+	// サイズが不正な場合、領域の検証は行わない
+	fieldErrs := model.FieldErrors(err)
+	s.Require().Len(fieldErrs, 1)
+	assert.Equal(s.T(), "size.height", fieldErrs[0].Field)
+}
+
+func (s *RichMenuTestSuite) TestBuildLineRichMenu() {
+	definition := s.definition()
+
+	raw, err := json.Marshal(external.BuildLineRichMenu(&definition))
+
+	s.Require().NoError(err)
+	assert.JSONEq(s.T(), `{
+		"size": {"width": 2500, "height": 843},
+		"selected": false,
+		"name": "配信メニュー",
+		"chatBarText": "メニュー",
+		"areas": [
+			{"bounds": {"x": 0, "y": 0, "width": 1250, "height": 843}, "action": {"type": "uri", "label": "チャンネル", "uri": "https://example.com/channel"}},
+			{"bounds": {"x": 1250, "y": 0, "width": 1250, "height": 843}, "action": {"type": "message", "text": "配信予定"}}
+		]
+	}`, string(raw))
+}
+
+func (s *RichMenuTestSuite) TestCreateRichMenu_RegistersOnLine() {
+	s.mockClient.EXPECT().CreateRichMenu(s.ctx, mock.AnythingOfType("*model.RichMenuDefinition")).Return("richmenu-new", nil).Once()
+	s.mockRichMenuRepo.EXPECT().Create(s.ctx, mock.MatchedBy(func(m *model.RichMenu) bool {
+		return m.LineRichMenuID == "richmenu-new" && m.Revision == 1 && m.AppliedAt != nil
+	})).Return(nil).Once()
+
+	menu, err := s.interactor.CreateRichMenu(s.ctx, &richmenu.CreateRichMenuInput{RichMenuDefinition: s.definition()})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "richmenu-new", menu.LineRichMenuID)
+	assert.False(s.T(), menu.HasImage())
+}
+
+func (s *RichMenuTestSuite) TestCreateRichMenu_LineFailure() {
+	s.mockClient.EXPECT().CreateRichMenu(s.ctx, mock.AnythingOfType("*model.RichMenuDefinition")).Return("", errors.New("LINE API error: status 500")).Once()
+
+	_, err := s.interactor.CreateRichMenu(s.ctx, &richmenu.CreateRichMenuInput{RichMenuDefinition: s.definition()})
+
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	assert.Equal(s.T(), 502, appErr.Status)
+}
+
+func (s *RichMenuTestSuite) TestUploadImage_FirstImageUploadsToExistingMenu() {
+	menu := s.appliedMenu("richmenu-1", false)
+	png := []byte("png")
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	s.mockClient.EXPECT().UploadRichMenuImage(s.ctx, "richmenu-1", "image/png", png).Return(nil).Once()
+	s.mockRichMenuRepo.EXPECT().Update(s.ctx, menu).Return(nil).Once()
+
+	updated, err := s.interactor.UploadImage(s.ctx, &richmenu.UploadImageInput{ID: menu.ID, ContentType: "image/png", Image: png})
+
+	s.Require().NoError(err)
+	assert.True(s.T(), updated.HasImage())
+	assert.Equal(s.T(), "richmenu-1", updated.LineRichMenuID)
+}
+
+func (s *RichMenuTestSuite) TestUploadImage_RejectsUnsupportedType() {
+	menu := s.appliedMenu("richmenu-1", false)
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+
+	_, err := s.interactor.UploadImage(s.ctx, &richmenu.UploadImageInput{ID: menu.ID, ContentType: "image/gif", Image: []byte("gif")})
+
+	assert.Equal(s.T(), []string{"content_type"}, s.fields(err))
+}
+
+func (s *RichMenuTestSuite) TestUploadImage_ReplacingImageRecreatesMenu() {
+	menu := s.appliedMenu("richmenu-old", true)
+	menu.IsDefault = true
+	linked := []string{testLineUserID, "U0000000000000000000000000000abcd"}
+	jpeg := []byte("jpeg")
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	// 新しいリッチメニューに画像・既定の設定・リンクを引き継いでから、以前のリッチメニューを削除する
+	s.mockClient.EXPECT().CreateRichMenu(s.ctx, &menu.RichMenuDefinition).Return("richmenu-new", nil).Once()
+	s.mockClient.EXPECT().UploadRichMenuImage(s.ctx, "richmenu-new", "image/jpeg", jpeg).Return(nil).Once()
+	s.mockClient.EXPECT().SetDefaultRichMenu(s.ctx, "richmenu-new").Return(nil).Once()
+	s.mockRichMenuRepo.EXPECT().ListLinkedUserIDs(s.ctx, menu.ID).Return(linked, nil).Once()
+	s.mockClient.EXPECT().LinkRichMenu(s.ctx, "richmenu-new", linked).Return(nil).Once()
+	s.mockRichMenuRepo.EXPECT().Update(s.ctx, menu).Return(nil).Once()
+	s.mockClient.EXPECT().DeleteRichMenu(s.ctx, "richmenu-old").Return(nil).Once()
+
+	updated, err := s.interactor.UploadImage(s.ctx, &richmenu.UploadImageInput{ID: menu.ID, ContentType: "image/jpeg", Image: jpeg})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "richmenu-new", updated.LineRichMenuID)
+	assert.Equal(s.T(), "image/jpeg", updated.ImageContentType)
+	assert.Equal(s.T(), s.clock.now, *updated.AppliedAt)
+}
+
+func (s *RichMenuTestSuite) TestUpdateRichMenu_RestoreFailureKeepsPreviousMenu() {
+	menu := s.appliedMenu("richmenu-old", true)
+	definition := s.definition()
+	definition.ChatBarText = "お知らせ"
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	s.mockClient.EXPECT().CreateRichMenu(s.ctx, mock.AnythingOfType("*model.RichMenuDefinition")).Return("richmenu-new", nil).Once()
+	s.mockClient.EXPECT().UploadRichMenuImage(s.ctx, "richmenu-new", "image/png", menu.Image).Return(errors.New("LINE API error: status 400")).Once()
+	// 作りかけのリッチメニューだけを削除し、DBは更新しない
+	s.mockClient.EXPECT().DeleteRichMenu(s.ctx, "richmenu-new").Return(nil).Once()
+
+	_, err := s.interactor.UpdateRichMenu(s.ctx, &richmenu.UpdateRichMenuInput{ID: menu.ID, RichMenuDefinition: definition})
+
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	assert.Equal(s.T(), "RICH_MENU_UNAVAILABLE", appErr.Code)
+}
+
+func (s *RichMenuTestSuite) TestUpdateRichMenu_AdvancesRevision() {
+	menu := s.appliedMenu("richmenu-old", false)
+	definition := s.definition()
+	definition.Selected = true
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	s.mockClient.EXPECT().CreateRichMenu(s.ctx, mock.MatchedBy(func(d *model.RichMenuDefinition) bool {
+		return d.Selected
+	})).Return("richmenu-new", nil).Once()
+	s.mockRichMenuRepo.EXPECT().Update(s.ctx, menu).Return(nil).Once()
+	s.mockClient.EXPECT().DeleteRichMenu(s.ctx, "richmenu-old").Return(nil).Once()
+
+	updated, err := s.interactor.UpdateRichMenu(s.ctx, &richmenu.UpdateRichMenuInput{ID: menu.ID, RichMenuDefinition: definition})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 2, updated.Revision)
+	assert.Equal(s.T(), "richmenu-new", updated.LineRichMenuID)
+}
+
+func (s *RichMenuTestSuite) TestApplyRichMenu_AfterChannelReset() {
+	menu := s.appliedMenu("richmenu-gone", true)
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	s.mockClient.EXPECT().CreateRichMenu(s.ctx, &menu.RichMenuDefinition).Return("richmenu-new", nil).Once()
+	s.mockClient.EXPECT().UploadRichMenuImage(s.ctx, "richmenu-new", "image/png", menu.Image).Return(nil).Once()
+	s.mockRichMenuRepo.EXPECT().ListLinkedUserIDs(s.ctx, menu.ID).Return(nil, nil).Once()
+	s.mockRichMenuRepo.EXPECT().Update(s.ctx, menu).Return(nil).Once()
+	// 以前のリッチメニューはLINE上に既に存在しない
+	s.mockClient.EXPECT().DeleteRichMenu(s.ctx, "richmenu-gone").Return(model.ErrLineRichMenuNotFound).Once()
+
+	applied, err := s.interactor.ApplyRichMenu(s.ctx, menu.ID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "richmenu-new", applied.LineRichMenuID)
+}
+
+func (s *RichMenuTestSuite) TestSetDefault_RequiresImage() {
+	menu := s.appliedMenu("richmenu-1", false)
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+
+	_, err := s.interactor.SetDefault(s.ctx, menu.ID)
+
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	assert.Equal(s.T(), "RICH_MENU_IMAGE_REQUIRED", appErr.Code)
+}
+
+func (s *RichMenuTestSuite) TestSetDefault_MissingOnLine() {
+	menu := s.appliedMenu("richmenu-gone", true)
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	s.mockClient.EXPECT().SetDefaultRichMenu(s.ctx, "richmenu-gone").Return(model.ErrLineRichMenuNotFound).Once()
+
+	_, err := s.interactor.SetDefault(s.ctx, menu.ID)
+
+	appErr, ok := errx.IsAppError(err)
+	s.Require().True(ok)
+	assert.Equal(s.T(), "RICH_MENU_NOT_ON_LINE", appErr.Code)
+}
+
+func (s *RichMenuTestSuite) TestLinkUsers_InBatches() {
+	menu := s.appliedMenu("richmenu-1", true)
+	userIDs := lineUserIDs(501)
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	s.mockClient.EXPECT().LinkRichMenu(s.ctx, "richmenu-1", mock.MatchedBy(func(ids []string) bool { return len(ids) == 500 })).Return(nil).Once()
+	s.mockClient.EXPECT().LinkRichMenu(s.ctx, "richmenu-1", mock.MatchedBy(func(ids []string) bool { return len(ids) == 1 })).Return(nil).Once()
+	s.mockRichMenuRepo.EXPECT().LinkUsers(s.ctx, menu.ID, mock.Anything, s.clock.now).Return(nil).Twice()
+
+	err := s.interactor.LinkUsers(s.ctx, &richmenu.LinkUsersInput{ID: menu.ID, UserIDs: userIDs})
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *RichMenuTestSuite) TestLinkUsers_RejectsInvalidUserIDs() {
+	err := s.interactor.LinkUsers(s.ctx, &richmenu.LinkUsersInput{UserIDs: []string{testLineUserID, "user-2"}})
+
+	assert.Equal(s.T(), []string{"user_ids[1]"}, s.fields(err))
+}
+
+func (s *RichMenuTestSuite) TestDeleteRichMenu_AlreadyGoneOnLine() {
+	menu := s.appliedMenu("richmenu-gone", true)
+	s.mockRichMenuRepo.EXPECT().FindByID(s.ctx, menu.ID).Return(menu, nil).Once()
+	s.mockClient.EXPECT().DeleteRichMenu(s.ctx, "richmenu-gone").Return(model.ErrLineRichMenuNotFound).Once()
+	s.mockRichMenuRepo.EXPECT().Delete(s.ctx, menu.ID).Return(nil).Once()
+
+	err := s.interactor.DeleteRichMenu(s.ctx, menu.ID)
+
+	assert.NoError(s.T(), err)
+}
+
+func TestRichMenuTestSuite(t *testing.T) {
+	suite.Run(t, new(RichMenuTestSuite))
+}
@@ -0,0 +1,125 @@
+package unit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/application/tag"
+	"vt-link/backend/internal/domain/model"
+	repoMocks "vt-link/backend/internal/domain/repository/mocks"
+	"vt-link/backend/internal/shared/errx"
+)
+
+type TagTestSuite struct {
+	suite.Suite
+	interactor  tag.Usecase
+	mockTagRepo *repoMocks.MockTagRepository
+	mockTxMgr   *repoMocks.MockTxManager
+	ctx         context.Context
+}
+
+func (s *TagTestSuite) SetupTest() {
+	s.mockTagRepo = repoMocks.NewMockTagRepository(s.T())
+	s.mockTxMgr = repoMocks.NewMockTxManager(s.T())
+	s.interactor = tag.NewInteractor(s.mockTagRepo, s.mockTxMgr)
+	s.ctx = context.Background()
+
+	s.mockTxMgr.EXPECT().WithinTx(s.ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
+}
+
+func (s *TagTestSuite) TestNewTag_Normalizes() {
+	created, err := model.NewTag("  配信予定 ", "#ff8800")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "配信予定", created.Name)
+	assert.Equal(s.T(), "#FF8800", created.Color)
+
+	withDefault, err := model.NewTag("グッズ", "")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.DefaultTagColor, withDefault.Color)
+}
+
+func (s *TagTestSuite) TestNewTag_Invalid() {
+	for _, tc := range []struct{ name, color string }{
+		{"  ", ""},
+		{strings.Repeat("あ", model.TagNameMaxLength+1), ""},
+		{"コラボ", "orange"},
+	} {
+		_, err := model.NewTag(tc.name, tc.color)
+		assert.ErrorIs(s.T(), err, model.ErrInvalidTag)
+	}
+}
+
+func (s *TagTestSuite) TestSetTags_DeduplicatesAndLimits() {
+	message := model.NewMessage("告知", "本文")
+	first, _ := model.NewTag("配信予定", "")
+
+	assert.NoError(s.T(), message.SetTags([]*model.Tag{first, first}))
+	assert.Equal(s.T(), []uuid.UUID{first.ID}, message.TagIDs())
+
+	tooMany := make([]*model.Tag, model.MessageMaxTags+1)
+	for i := range tooMany {
+		tooMany[i] = &model.Tag{ID: uuid.New()}
+	}
+	assert.ErrorIs(s.T(), message.SetTags(tooMany), model.ErrInvalidTag)
+}
+
+func (s *TagTestSuite) TestCreateTag_Success() {
+	s.mockTagRepo.EXPECT().FindByName(s.ctx, "コラボ").Return(nil, assert.AnError).Once()
+	s.mockTagRepo.EXPECT().Create(s.ctx, mock.MatchedBy(func(t *model.Tag) bool {
+		return t.Name == "コラボ" && t.Color == model.DefaultTagColor
+	})).Return(nil).Once()
+
+	created, err := s.interactor.CreateTag(s.ctx, &tag.CreateTagInput{Name: "コラボ"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "コラボ", created.Name)
+}
+
+func (s *TagTestSuite) TestCreateTag_NameTaken() {
+	existing, _ := model.NewTag("Merch", "")
+	s.mockTagRepo.EXPECT().FindByName(s.ctx, "merch").Return(existing, nil).Once()
+
+	created, err := s.interactor.CreateTag(s.ctx, &tag.CreateTagInput{Name: "merch"})
+
+	assert.Nil(s.T(), created)
+	appErr, ok := errx.IsAppError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "TAG_NAME_TAKEN", appErr.Code)
+	s.mockTagRepo.AssertNotCalled(s.T(), "Create")
+}
+
+func (s *TagTestSuite) TestUpdateTag_ChangeCaseOfOwnName() {
+	existing, _ := model.NewTag("merch", "")
+	s.mockTagRepo.EXPECT().FindByID(s.ctx, existing.ID).Return(existing, nil).Once()
+	s.mockTagRepo.EXPECT().FindByName(s.ctx, "Merch").Return(existing, nil).Once()
+	s.mockTagRepo.EXPECT().Update(s.ctx, existing).Return(nil).Once()
+
+	name := "Merch"
+	updated, err := s.interactor.UpdateTag(s.ctx, &tag.UpdateTagInput{ID: existing.ID, Name: &name})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "Merch", updated.Name)
+}
+
+func (s *TagTestSuite) TestDeleteTag_NotFound() {
+	id := uuid.New()
+	s.mockTagRepo.EXPECT().Delete(s.ctx, id).Return(assert.AnError).Once()
+
+	err := s.interactor.DeleteTag(s.ctx, id)
+
+	assert.Equal(s.T(), errx.ErrNotFound, err)
+}
+
+func TestTagTestSuite(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
@@ -0,0 +1,139 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/domain/model"
+)
+
+type TemplateTestSuite struct {
+	suite.Suite
+	buttons *model.TemplateContent
+}
+
+func (s *TemplateTestSuite) SetupTest() {
+	s.buttons = &model.TemplateContent{
+		Type:              model.TemplateTypeButtons,
+		AltText:           "グッズ販売のお知らせ",
+		ThumbnailImageURL: "https://example.com/goods.png",
+		Title:             "新グッズ",
+		Text:              "本日20時から販売開始",
+		Actions: []model.Action{
+			{Type: model.ActionTypeURI, Label: "ショップへ", URI: "https://shop.example.com"},
+			{Type: model.ActionTypePostback, Label: "リマインド", Data: "action=remind&item=1", DisplayText: "リマインドする"},
+			{Type: model.ActionTypeMessage, Label: "質問する", Text: "グッズについて質問"},
+			{Type: model.ActionTypeDatetimePicker, Label: "日時を選ぶ", Data: "action=pick", Mode: "datetime"},
+		},
+	}
+}
+
+func (s *TemplateTestSuite) TestValidate_Buttons() {
+	assert.NoError(s.T(), s.buttons.Validate())
+}
+
+func (s *TemplateTestSuite) TestValidate_ButtonsTooManyActions() {
+	// ボタンは最大4つ
+	s.buttons.Actions = append(s.buttons.Actions, model.Action{Type: model.ActionTypeMessage, Label: "5つ目", Text: "5"})
+	assert.ErrorIs(s.T(), s.buttons.Validate(), model.ErrInvalidContent)
+}
+
+func (s *TemplateTestSuite) TestValidate_ActionLimits() {
+	testCases := []struct {
+		name   string
+		action model.Action
+	}{
+		{"label too long", model.Action{Type: model.ActionTypeMessage, Label: strings.Repeat("あ", model.ActionLabelMaxLength+1), Text: "a"}},
+		{"missing label", model.Action{Type: model.ActionTypeMessage, Text: "a"}},
+		{"javascript uri", model.Action{Type: model.ActionTypeURI, Label: "開く", URI: "javascript:alert(1)"}},
+		{"ftp uri", model.Action{Type: model.ActionTypeURI, Label: "開く", URI: "ftp://example.com/file"}},
+		{"postback without data", model.Action{Type: model.ActionTypePostback, Label: "送信"}},
+		{"datetimepicker invalid mode", model.Action{Type: model.ActionTypeDatetimePicker, Label: "日時", Data: "d", Mode: "week"}},
+		{"unknown type", model.Action{Type: "camera", Label: "カメラ"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.buttons.Actions[0] = tc.action
+			assert.ErrorIs(s.T(), s.buttons.Validate(), model.ErrInvalidContent)
+		})
+	}
+}
+
+func (s *TemplateTestSuite) TestValidate_AllowedURISchemes() {
+	for _, uri := range []string{"http://example.com", "https://example.com", "line://nv/profile", "tel:0312345678"} {
+		s.buttons.Actions[0] = model.Action{Type: model.ActionTypeURI, Label: "開く", URI: uri}
+		assert.NoError(s.T(), s.buttons.Validate(), uri)
+	}
+}
+
+func (s *TemplateTestSuite) TestValidate_TextLimitWithHeader() {
+	// 画像またはタイトルがある場合、本文は60文字まで
+	s.buttons.Text = strings.Repeat("あ", model.TemplateTextWithHeaderMaxLength+1)
+	assert.ErrorIs(s.T(), s.buttons.Validate(), model.ErrInvalidContent)
+
+	s.buttons.ThumbnailImageURL = ""
+	s.buttons.Title = ""
+	assert.NoError(s.T(), s.buttons.Validate())
+}
+
+func (s *TemplateTestSuite) TestValidate_Confirm() {
+	confirm := &model.TemplateContent{
+		Type:    model.TemplateTypeConfirm,
+		AltText: "参加確認",
+		Text:    "オフ会に参加しますか？",
+		Actions: []model.Action{
+			{Type: model.ActionTypeMessage, Label: "はい", Text: "はい"},
+			{Type: model.ActionTypeMessage, Label: "いいえ", Text: "いいえ"},
+		},
+	}
+	assert.NoError(s.T(), confirm.Validate())
+
+	// confirmはちょうど2つのアクションが必要
+	confirm.Actions = confirm.Actions[:1]
+	assert.ErrorIs(s.T(), confirm.Validate(), model.ErrInvalidContent)
+}
+
+func (s *TemplateTestSuite) TestValidate_CarouselActionCountMismatch() {
+	carousel := &model.TemplateContent{
+		Type:    model.TemplateTypeCarousel,
+		AltText: "配信スケジュール",
+		Columns: []model.TemplateColumn{
+			{Text: "月曜", Actions: []model.Action{{Type: model.ActionTypeMessage, Label: "詳細", Text: "月"}}},
+			{Text: "火曜", Actions: []model.Action{
+				{Type: model.ActionTypeMessage, Label: "詳細", Text: "火"},
+				{Type: model.ActionTypeMessage, Label: "通知", Text: "火通知"},
+			}},
+		},
+	}
+	assert.ErrorIs(s.T(), carousel.Validate(), model.ErrInvalidContent)
+
+	carousel.Columns[1].Actions = carousel.Columns[1].Actions[:1]
+	assert.NoError(s.T(), carousel.Validate())
+}
+
+func (s *TemplateTestSuite) TestValidate_ImageCarouselLabelLimit() {
+	imageCarousel := &model.TemplateContent{
+		Type:    model.TemplateTypeImageCarousel,
+		AltText: "新衣装",
+		Columns: []model.TemplateColumn{
+			{
+				ImageURL: "https://example.com/outfit.png",
+				Action:   &model.Action{Type: model.ActionTypeURI, Label: "詳しく見る", URI: "https://example.com"},
+			},
+		},
+	}
+	assert.NoError(s.T(), imageCarousel.Validate())
+
+	// image_carousel のラベルは12文字まで
+	imageCarousel.Columns[0].Action.Label = strings.Repeat("あ", model.TemplateImageCarouselLabelMaxLength+1)
+	assert.ErrorIs(s.T(), imageCarousel.Validate(), model.ErrInvalidContent)
+}
+
+func TestTemplateTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateTestSuite))
+}
@@ -0,0 +1,211 @@
+package unit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"vt-link/backend/internal/application/webhook"
+	"vt-link/backend/internal/domain/model"
+	"vt-link/backend/internal/domain/service/mocks"
+	"vt-link/backend/internal/infrastructure/external"
+	"vt-link/backend/internal/shared/errx"
+)
+
+const testChannelSecret = "test-channel-secret"
+
+// webhookBody 友だち追加・テキスト・ポストバック・メンバー参加のイベントを含むリクエストボディ
+const webhookBody = `{
+	"destination": "Ubot",
+	"events": [
+		{
+			"type": "follow",
+			"mode": "active",
+			"timestamp": 1704067200000,
+			"source": {"type": "user", "userId": "U0001"},
+			"webhookEventId": "01HFOLLOW",
+			"deliveryContext": {"isRedelivery": false},
+			"replyToken": "reply-follow",
+			"follow": {"isUnblocked": true}
+		},
+		{
+			"type": "message",
+			"mode": "active",
+			"timestamp": 1704067201000,
+			"source": {"type": "group", "groupId": "C0001", "userId": "U0002"},
+			"webhookEventId": "01HMESSAGE",
+			"deliveryContext": {"isRedelivery": true},
+			"replyToken": "reply-message",
+			"message": {
+				"id": "468789577898262530",
+				"type": "text",
+				"quoteToken": "q1",
+				"text": "@bot こんにちは $",
+				"emojis": [{"index": 12, "length": 1, "productId": "5ac1bfd5040ab15980c9b435", "emojiId": "001"}],
+				"mention": {"mentionees": [{"index": 0, "length": 4, "type": "user", "userId": "Ubot", "isSelf": true}]}
+			}
+		},
+		{
+			"type": "postback",
+			"mode": "active",
+			"timestamp": 1704067202000,
+			"source": {"type": "user", "userId": "U0001"},
+			"webhookEventId": "01HPOSTBACK",
+			"deliveryContext": {"isRedelivery": false},
+			"replyToken": "reply-postback",
+			"postback": {"data": "action=buy", "params": {"datetime": "2024-01-01T12:00"}}
+		},
+		{
+			"type": "memberJoined",
+			"mode": "active",
+			"timestamp": 1704067203000,
+			"source": {"type": "group", "groupId": "C0001"},
+			"webhookEventId": "01HJOINED",
+			"deliveryContext": {"isRedelivery": false},
+			"joined": {"members": [{"type": "user", "userId": "U0003"}]}
+		}
+	]
+}`
+
+// signWebhook LINEと同じ方法でリクエストボディに署名
+func signWebhook(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type WebhookTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	mockParser *mocks.MockWebhookParser
+}
+
+func (s *WebhookTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockParser = mocks.NewMockWebhookParser(s.T())
+}
+
+func (s *WebhookTestSuite) TestVerifyLineSignature() {
+	signature := signWebhook(testChannelSecret, webhookBody)
+
+	assert.True(s.T(), external.VerifyLineSignature(testChannelSecret, []byte(webhookBody), signature))
+	assert.False(s.T(), external.VerifyLineSignature("other-secret", []byte(webhookBody), signature))
+	assert.False(s.T(), external.VerifyLineSignature(testChannelSecret, []byte(webhookBody+" "), signature))
+	assert.False(s.T(), external.VerifyLineSignature(testChannelSecret, []byte(webhookBody), "not base64!"))
+}
+
+func (s *WebhookTestSuite) TestParse_TypedEvents() {
+	s.T().Setenv("LINE_CHANNEL_SECRET", testChannelSecret)
+	parser := external.NewLineWebhookParser()
+
+	payload, err := parser.Parse([]byte(webhookBody), signWebhook(testChannelSecret, webhookBody))
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Ubot", payload.Destination)
+	s.Require().Len(payload.Events, 4)
+
+	follow := payload.Events[0]
+	assert.Equal(s.T(), model.WebhookEventFollow, follow.Type)
+	assert.Equal(s.T(), "U0001", follow.UserID())
+	assert.True(s.T(), follow.Timestamp.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(s.T(), &model.WebhookFollow{IsUnblocked: true}, follow.Follow)
+
+	message := payload.Events[1]
+	assert.Equal(s.T(), model.WebhookEventMessage, message.Type)
+	assert.True(s.T(), message.IsRedelivery)
+	assert.Equal(s.T(), model.WebhookSourceGroup, message.Source.Type)
+	assert.Equal(s.T(), "reply-message", message.ReplyToken)
+	s.Require().NotNil(message.Message)
+	assert.Equal(s.T(), model.WebhookMessageText, message.Message.Type)
+	assert.Equal(s.T(), "@bot こんにちは $", message.Message.Text)
+	assert.Equal(s.T(), []model.TextEmoji{{Index: 12, ProductID: "5ac1bfd5040ab15980c9b435", EmojiID: "001"}}, message.Message.Emojis)
+	assert.Equal(s.T(), []model.WebhookMentionee{{Index: 0, Length: 4, Type: "user", UserID: "Ubot", IsSelf: true}}, message.Message.Mentionees)
+
+	postback := payload.Events[2]
+	assert.Equal(s.T(), &model.WebhookPostback{Data: "action=buy", Params: map[string]string{"datetime": "2024-01-01T12:00"}}, postback.Postback)
+
+	joined := payload.Events[3]
+	assert.Equal(s.T(), "", joined.UserID())
+	assert.Equal(s.T(), &model.WebhookMembers{Members: []model.WebhookSource{{Type: model.WebhookSourceUser, UserID: "U0003"}}}, joined.Joined)
+}
+
+func (s *WebhookTestSuite) TestParse_RejectsInvalidSignature() {
+	s.T().Setenv("LINE_CHANNEL_SECRET", testChannelSecret)
+	parser := external.NewLineWebhookParser()
+
+	payload, err := parser.Parse([]byte(webhookBody), signWebhook("other-secret", webhookBody))
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidSignature)
+	assert.Nil(s.T(), payload)
+}
+
+func (s *WebhookTestSuite) TestParse_RequiresChannelSecret() {
+	s.T().Setenv("LINE_CHANNEL_SECRET", "")
+	parser := external.NewLineWebhookParser()
+
+	_, err := parser.Parse([]byte(webhookBody), signWebhook("", webhookBody))
+
+	assert.ErrorIs(s.T(), err, model.ErrWebhookNotConfigured)
+}
+
+func (s *WebhookTestSuite) TestHandleWebhook_DispatchesByType() {
+	follow := &model.WebhookEvent{Type: model.WebhookEventFollow, WebhookEventID: "1"}
+	message := &model.WebhookEvent{Type: model.WebhookEventMessage, WebhookEventID: "2"}
+	unfollow := &model.WebhookEvent{Type: model.WebhookEventUnfollow, WebhookEventID: "3"}
+	s.mockParser.EXPECT().Parse([]byte("body"), "signature").
+		Return(&model.WebhookPayload{Events: []*model.WebhookEvent{follow, message, unfollow}}, nil).Once()
+
+	var handled []string
+	record := func(name string) webhook.EventHandler {
+		return webhook.EventHandlerFunc(func(ctx context.Context, event *model.WebhookEvent) error {
+			handled = append(handled, name+":"+event.WebhookEventID)
+			return nil
+		})
+	}
+	failing := webhook.EventHandlerFunc(func(ctx context.Context, event *model.WebhookEvent) error {
+		return errors.New("handler failed")
+	})
+	interactor := webhook.NewInteractor(s.mockParser, webhook.Handlers{
+		model.WebhookEventFollow:  {failing, record("follow")},
+		model.WebhookEventMessage: {record("message-a"), record("message-b")},
+	})
+
+	err := interactor.HandleWebhook(s.ctx, &webhook.HandleWebhookInput{Body: []byte("body"), Signature: "signature"})
+
+	// 失敗した処理があっても後続の処理は実行し、処理のない種別は無視する
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{"follow:1", "message-a:2", "message-b:2"}, handled)
+}
+
+func (s *WebhookTestSuite) TestHandleWebhook_MapsParseErrors() {
+	interactor := webhook.NewInteractor(s.mockParser, webhook.Handlers{})
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{model.ErrInvalidSignature, 401},
+		{model.ErrWebhookNotConfigured, 503},
+		{errors.New("unexpected end of JSON input"), 400},
+	}
+
+	for _, tc := range cases {
+		s.mockParser.EXPECT().Parse([]byte("body"), "signature").Return(nil, tc.err).Once()
+
+		err := interactor.HandleWebhook(s.ctx, &webhook.HandleWebhookInput{Body: []byte("body"), Signature: "signature"})
+
+		appErr, ok := errx.IsAppError(err)
+		s.Require().True(ok, tc.err.Error())
+		assert.Equal(s.T(), tc.status, appErr.Status, tc.err.Error())
+	}
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}